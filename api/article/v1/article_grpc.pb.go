@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Article_ListArticles_FullMethodName      = "/article.v1.Article/ListArticles"
 	Article_FeedArticles_FullMethodName      = "/article.v1.Article/FeedArticles"
+	Article_ListMyDrafts_FullMethodName      = "/article.v1.Article/ListMyDrafts"
+	Article_SearchArticles_FullMethodName    = "/article.v1.Article/SearchArticles"
 	Article_GetArticle_FullMethodName        = "/article.v1.Article/GetArticle"
 	Article_CreateArticle_FullMethodName     = "/article.v1.Article/CreateArticle"
 	Article_UpdateArticle_FullMethodName     = "/article.v1.Article/UpdateArticle"
@@ -28,9 +30,26 @@ const (
 	Article_AddComment_FullMethodName        = "/article.v1.Article/AddComment"
 	Article_GetComments_FullMethodName       = "/article.v1.Article/GetComments"
 	Article_DeleteComment_FullMethodName     = "/article.v1.Article/DeleteComment"
+	Article_ListTrash_FullMethodName         = "/article.v1.Article/ListTrash"
+	Article_RestoreArticle_FullMethodName    = "/article.v1.Article/RestoreArticle"
+	Article_RestoreComment_FullMethodName    = "/article.v1.Article/RestoreComment"
 	Article_FavoriteArticle_FullMethodName   = "/article.v1.Article/FavoriteArticle"
 	Article_UnfavoriteArticle_FullMethodName = "/article.v1.Article/UnfavoriteArticle"
 	Article_GetTags_FullMethodName           = "/article.v1.Article/GetTags"
+	Article_CreateSeries_FullMethodName      = "/article.v1.Article/CreateSeries"
+	Article_GetSeries_FullMethodName         = "/article.v1.Article/GetSeries"
+	Article_UpdateSeries_FullMethodName      = "/article.v1.Article/UpdateSeries"
+	Article_SetSeriesArticles_FullMethodName = "/article.v1.Article/SetSeriesArticles"
+	Article_DeleteSeries_FullMethodName      = "/article.v1.Article/DeleteSeries"
+	Article_InviteCoAuthor_FullMethodName    = "/article.v1.Article/InviteCoAuthor"
+	Article_AcceptCoAuthor_FullMethodName    = "/article.v1.Article/AcceptCoAuthor"
+	Article_RemoveCoAuthor_FullMethodName    = "/article.v1.Article/RemoveCoAuthor"
+	Article_ListInvitations_FullMethodName   = "/article.v1.Article/ListInvitations"
+	Article_RenderMarkdown_FullMethodName    = "/article.v1.Article/RenderMarkdown"
+	Article_ListRevisions_FullMethodName     = "/article.v1.Article/ListRevisions"
+	Article_GetRevision_FullMethodName       = "/article.v1.Article/GetRevision"
+	Article_DiffRevisions_FullMethodName     = "/article.v1.Article/DiffRevisions"
+	Article_RestoreRevision_FullMethodName   = "/article.v1.Article/RestoreRevision"
 )
 
 // ArticleClient is the client API for Article service.
@@ -39,16 +58,54 @@ const (
 type ArticleClient interface {
 	ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...grpc.CallOption) (*MultipleArticlesReply, error)
 	FeedArticles(ctx context.Context, in *FeedArticlesRequest, opts ...grpc.CallOption) (*MultipleArticlesReply, error)
+	// Draft and scheduled articles of the current user.
+	ListMyDrafts(ctx context.Context, in *ListMyDraftsRequest, opts ...grpc.CallOption) (*MultipleArticlesReply, error)
+	// Full-text search of the published articles, best matches first.
+	SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesReply, error)
 	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
-	GetComments(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*MultipleCommentsReply, error)
+	GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*MultipleCommentsReply, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
+	// The deleted articles the current user owns and the comments they
+	// deleted, until they are purged.
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*TrashReply, error)
+	// Takes an article of the current user out of the trash.
+	RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// Takes a comment of the current user out of the trash. Its article must
+	// not be deleted.
+	RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error)
 	FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	UnfavoriteArticle(ctx context.Context, in *UnfavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*TagListReply, error)
+	// Series are ordered lists of articles of one author.
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error)
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error)
+	UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error)
+	// Replaces the articles of a series by the given ones, in order. This adds,
+	// reorders and removes articles.
+	SetSeriesArticles(ctx context.Context, in *SetSeriesArticlesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error)
+	// Deletes a series, its articles stay.
+	DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error)
+	// Invites a user to co-author an article. Only its owner may invite.
+	InviteCoAuthor(ctx context.Context, in *InviteCoAuthorRequest, opts ...grpc.CallOption) (*SingleInvitationReply, error)
+	// Accepts the invitation of the current user to co-author an article.
+	AcceptCoAuthor(ctx context.Context, in *AcceptCoAuthorRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// Removes a co-author or withdraws an invitation. The owner may remove
+	// anyone, other users only themselves.
+	RemoveCoAuthor(ctx context.Context, in *RemoveCoAuthorRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
+	// Pending invitations of the current user, the latest first.
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*MultipleInvitationsReply, error)
+	// Renders a Markdown body the way article bodies are rendered, for previews.
+	RenderMarkdown(ctx context.Context, in *RenderMarkdownRequest, opts ...grpc.CallOption) (*RenderMarkdownReply, error)
+	// Revisions of an article, the latest first. Only its author can see them.
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*MultipleRevisionsReply, error)
+	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*SingleRevisionReply, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*RevisionDiffReply, error)
+	// Makes a revision the current version of the article, as a new revision.
+	RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*SingleArticleReply, error)
 }
 
 type articleClient struct {
@@ -77,6 +134,24 @@ func (c *articleClient) FeedArticles(ctx context.Context, in *FeedArticlesReques
 	return out, nil
 }
 
+func (c *articleClient) ListMyDrafts(ctx context.Context, in *ListMyDraftsRequest, opts ...grpc.CallOption) (*MultipleArticlesReply, error) {
+	out := new(MultipleArticlesReply)
+	err := c.cc.Invoke(ctx, Article_ListMyDrafts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...grpc.CallOption) (*SearchArticlesReply, error) {
+	out := new(SearchArticlesReply)
+	err := c.cc.Invoke(ctx, Article_SearchArticles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleClient) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
 	out := new(SingleArticleReply)
 	err := c.cc.Invoke(ctx, Article_GetArticle_FullMethodName, in, out, opts...)
@@ -122,7 +197,7 @@ func (c *articleClient) AddComment(ctx context.Context, in *AddCommentRequest, o
 	return out, nil
 }
 
-func (c *articleClient) GetComments(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*MultipleCommentsReply, error) {
+func (c *articleClient) GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*MultipleCommentsReply, error) {
 	out := new(MultipleCommentsReply)
 	err := c.cc.Invoke(ctx, Article_GetComments_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -140,6 +215,33 @@ func (c *articleClient) DeleteComment(ctx context.Context, in *DeleteCommentRequ
 	return out, nil
 }
 
+func (c *articleClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*TrashReply, error) {
+	out := new(TrashReply)
+	err := c.cc.Invoke(ctx, Article_ListTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, Article_RestoreArticle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...grpc.CallOption) (*SingleCommentReply, error) {
+	out := new(SingleCommentReply)
+	err := c.cc.Invoke(ctx, Article_RestoreComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleClient) FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
 	out := new(SingleArticleReply)
 	err := c.cc.Invoke(ctx, Article_FavoriteArticle_FullMethodName, in, out, opts...)
@@ -167,22 +269,186 @@ func (c *articleClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ..
 	return out, nil
 }
 
+func (c *articleClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error) {
+	out := new(SingleSeriesReply)
+	err := c.cc.Invoke(ctx, Article_CreateSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error) {
+	out := new(SingleSeriesReply)
+	err := c.cc.Invoke(ctx, Article_GetSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error) {
+	out := new(SingleSeriesReply)
+	err := c.cc.Invoke(ctx, Article_UpdateSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) SetSeriesArticles(ctx context.Context, in *SetSeriesArticlesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error) {
+	out := new(SingleSeriesReply)
+	err := c.cc.Invoke(ctx, Article_SetSeriesArticles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*SingleSeriesReply, error) {
+	out := new(SingleSeriesReply)
+	err := c.cc.Invoke(ctx, Article_DeleteSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) InviteCoAuthor(ctx context.Context, in *InviteCoAuthorRequest, opts ...grpc.CallOption) (*SingleInvitationReply, error) {
+	out := new(SingleInvitationReply)
+	err := c.cc.Invoke(ctx, Article_InviteCoAuthor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) AcceptCoAuthor(ctx context.Context, in *AcceptCoAuthorRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, Article_AcceptCoAuthor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) RemoveCoAuthor(ctx context.Context, in *RemoveCoAuthorRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, Article_RemoveCoAuthor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*MultipleInvitationsReply, error) {
+	out := new(MultipleInvitationsReply)
+	err := c.cc.Invoke(ctx, Article_ListInvitations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) RenderMarkdown(ctx context.Context, in *RenderMarkdownRequest, opts ...grpc.CallOption) (*RenderMarkdownReply, error) {
+	out := new(RenderMarkdownReply)
+	err := c.cc.Invoke(ctx, Article_RenderMarkdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*MultipleRevisionsReply, error) {
+	out := new(MultipleRevisionsReply)
+	err := c.cc.Invoke(ctx, Article_ListRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*SingleRevisionReply, error) {
+	out := new(SingleRevisionReply)
+	err := c.cc.Invoke(ctx, Article_GetRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*RevisionDiffReply, error) {
+	out := new(RevisionDiffReply)
+	err := c.cc.Invoke(ctx, Article_DiffRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleClient) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...grpc.CallOption) (*SingleArticleReply, error) {
+	out := new(SingleArticleReply)
+	err := c.cc.Invoke(ctx, Article_RestoreRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArticleServer is the server API for Article service.
 // All implementations must embed UnimplementedArticleServer
 // for forward compatibility
 type ArticleServer interface {
 	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticlesReply, error)
 	FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticlesReply, error)
+	// Draft and scheduled articles of the current user.
+	ListMyDrafts(context.Context, *ListMyDraftsRequest) (*MultipleArticlesReply, error)
+	// Full-text search of the published articles, best matches first.
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error)
 	GetArticle(context.Context, *GetArticleRequest) (*SingleArticleReply, error)
 	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleReply, error)
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleReply, error)
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*SingleArticleReply, error)
 	AddComment(context.Context, *AddCommentRequest) (*SingleCommentReply, error)
-	GetComments(context.Context, *AddCommentRequest) (*MultipleCommentsReply, error)
+	GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentsReply, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*SingleCommentReply, error)
+	// The deleted articles the current user owns and the comments they
+	// deleted, until they are purged.
+	ListTrash(context.Context, *ListTrashRequest) (*TrashReply, error)
+	// Takes an article of the current user out of the trash.
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*SingleArticleReply, error)
+	// Takes a comment of the current user out of the trash. Its article must
+	// not be deleted.
+	RestoreComment(context.Context, *RestoreCommentRequest) (*SingleCommentReply, error)
 	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleReply, error)
 	UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticleReply, error)
 	GetTags(context.Context, *GetTagsRequest) (*TagListReply, error)
+	// Series are ordered lists of articles of one author.
+	CreateSeries(context.Context, *CreateSeriesRequest) (*SingleSeriesReply, error)
+	GetSeries(context.Context, *GetSeriesRequest) (*SingleSeriesReply, error)
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*SingleSeriesReply, error)
+	// Replaces the articles of a series by the given ones, in order. This adds,
+	// reorders and removes articles.
+	SetSeriesArticles(context.Context, *SetSeriesArticlesRequest) (*SingleSeriesReply, error)
+	// Deletes a series, its articles stay.
+	DeleteSeries(context.Context, *DeleteSeriesRequest) (*SingleSeriesReply, error)
+	// Invites a user to co-author an article. Only its owner may invite.
+	InviteCoAuthor(context.Context, *InviteCoAuthorRequest) (*SingleInvitationReply, error)
+	// Accepts the invitation of the current user to co-author an article.
+	AcceptCoAuthor(context.Context, *AcceptCoAuthorRequest) (*SingleArticleReply, error)
+	// Removes a co-author or withdraws an invitation. The owner may remove
+	// anyone, other users only themselves.
+	RemoveCoAuthor(context.Context, *RemoveCoAuthorRequest) (*SingleArticleReply, error)
+	// Pending invitations of the current user, the latest first.
+	ListInvitations(context.Context, *ListInvitationsRequest) (*MultipleInvitationsReply, error)
+	// Renders a Markdown body the way article bodies are rendered, for previews.
+	RenderMarkdown(context.Context, *RenderMarkdownRequest) (*RenderMarkdownReply, error)
+	// Revisions of an article, the latest first. Only its author can see them.
+	ListRevisions(context.Context, *ListRevisionsRequest) (*MultipleRevisionsReply, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*SingleRevisionReply, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*RevisionDiffReply, error)
+	// Makes a revision the current version of the article, as a new revision.
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*SingleArticleReply, error)
 	mustEmbedUnimplementedArticleServer()
 }
 
@@ -196,6 +462,12 @@ func (UnimplementedArticleServer) ListArticles(context.Context, *ListArticlesReq
 func (UnimplementedArticleServer) FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticlesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeedArticles not implemented")
 }
+func (UnimplementedArticleServer) ListMyDrafts(context.Context, *ListMyDraftsRequest) (*MultipleArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyDrafts not implemented")
+}
+func (UnimplementedArticleServer) SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArticles not implemented")
+}
 func (UnimplementedArticleServer) GetArticle(context.Context, *GetArticleRequest) (*SingleArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
 }
@@ -211,12 +483,21 @@ func (UnimplementedArticleServer) DeleteArticle(context.Context, *DeleteArticleR
 func (UnimplementedArticleServer) AddComment(context.Context, *AddCommentRequest) (*SingleCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
 }
-func (UnimplementedArticleServer) GetComments(context.Context, *AddCommentRequest) (*MultipleCommentsReply, error) {
+func (UnimplementedArticleServer) GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
 }
 func (UnimplementedArticleServer) DeleteComment(context.Context, *DeleteCommentRequest) (*SingleCommentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (UnimplementedArticleServer) ListTrash(context.Context, *ListTrashRequest) (*TrashReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedArticleServer) RestoreArticle(context.Context, *RestoreArticleRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreArticle not implemented")
+}
+func (UnimplementedArticleServer) RestoreComment(context.Context, *RestoreCommentRequest) (*SingleCommentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreComment not implemented")
+}
 func (UnimplementedArticleServer) FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FavoriteArticle not implemented")
 }
@@ -226,6 +507,48 @@ func (UnimplementedArticleServer) UnfavoriteArticle(context.Context, *Unfavorite
 func (UnimplementedArticleServer) GetTags(context.Context, *GetTagsRequest) (*TagListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
 }
+func (UnimplementedArticleServer) CreateSeries(context.Context, *CreateSeriesRequest) (*SingleSeriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedArticleServer) GetSeries(context.Context, *GetSeriesRequest) (*SingleSeriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (UnimplementedArticleServer) UpdateSeries(context.Context, *UpdateSeriesRequest) (*SingleSeriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (UnimplementedArticleServer) SetSeriesArticles(context.Context, *SetSeriesArticlesRequest) (*SingleSeriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSeriesArticles not implemented")
+}
+func (UnimplementedArticleServer) DeleteSeries(context.Context, *DeleteSeriesRequest) (*SingleSeriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeries not implemented")
+}
+func (UnimplementedArticleServer) InviteCoAuthor(context.Context, *InviteCoAuthorRequest) (*SingleInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteCoAuthor not implemented")
+}
+func (UnimplementedArticleServer) AcceptCoAuthor(context.Context, *AcceptCoAuthorRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCoAuthor not implemented")
+}
+func (UnimplementedArticleServer) RemoveCoAuthor(context.Context, *RemoveCoAuthorRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCoAuthor not implemented")
+}
+func (UnimplementedArticleServer) ListInvitations(context.Context, *ListInvitationsRequest) (*MultipleInvitationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedArticleServer) RenderMarkdown(context.Context, *RenderMarkdownRequest) (*RenderMarkdownReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderMarkdown not implemented")
+}
+func (UnimplementedArticleServer) ListRevisions(context.Context, *ListRevisionsRequest) (*MultipleRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedArticleServer) GetRevision(context.Context, *GetRevisionRequest) (*SingleRevisionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedArticleServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*RevisionDiffReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedArticleServer) RestoreRevision(context.Context, *RestoreRevisionRequest) (*SingleArticleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRevision not implemented")
+}
 func (UnimplementedArticleServer) mustEmbedUnimplementedArticleServer() {}
 
 // UnsafeArticleServer may be embedded to opt out of forward compatibility for this service.
@@ -275,6 +598,42 @@ func _Article_FeedArticles_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Article_ListMyDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyDraftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).ListMyDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_ListMyDrafts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).ListMyDrafts(ctx, req.(*ListMyDraftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_SearchArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).SearchArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_SearchArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).SearchArticles(ctx, req.(*SearchArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Article_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetArticleRequest)
 	if err := dec(in); err != nil {
@@ -366,7 +725,7 @@ func _Article_AddComment_Handler(srv interface{}, ctx context.Context, dec func(
 }
 
 func _Article_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddCommentRequest)
+	in := new(GetCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -378,7 +737,7 @@ func _Article_GetComments_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: Article_GetComments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ArticleServer).GetComments(ctx, req.(*AddCommentRequest))
+		return srv.(ArticleServer).GetComments(ctx, req.(*GetCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -401,6 +760,60 @@ func _Article_DeleteComment_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Article_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_RestoreArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).RestoreArticle(ctx, req.(*RestoreArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).RestoreComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_RestoreComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).RestoreComment(ctx, req.(*RestoreCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Article_FavoriteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FavoriteArticleRequest)
 	if err := dec(in); err != nil {
@@ -455,6 +868,258 @@ func _Article_GetTags_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Article_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_CreateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).GetSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_GetSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).GetSeries(ctx, req.(*GetSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).UpdateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_UpdateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).UpdateSeries(ctx, req.(*UpdateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_SetSeriesArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSeriesArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).SetSeriesArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_SetSeriesArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).SetSeriesArticles(ctx, req.(*SetSeriesArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_DeleteSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).DeleteSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_DeleteSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).DeleteSeries(ctx, req.(*DeleteSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_InviteCoAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteCoAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).InviteCoAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_InviteCoAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).InviteCoAuthor(ctx, req.(*InviteCoAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_AcceptCoAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCoAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).AcceptCoAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_AcceptCoAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).AcceptCoAuthor(ctx, req.(*AcceptCoAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_RemoveCoAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCoAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).RemoveCoAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_RemoveCoAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).RemoveCoAuthor(ctx, req.(*RemoveCoAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_RenderMarkdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderMarkdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).RenderMarkdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_RenderMarkdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).RenderMarkdown(ctx, req.(*RenderMarkdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_ListRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_GetRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Article_RestoreRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServer).RestoreRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Article_RestoreRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServer).RestoreRevision(ctx, req.(*RestoreRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Article_ServiceDesc is the grpc.ServiceDesc for Article service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -470,6 +1135,14 @@ var Article_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FeedArticles",
 			Handler:    _Article_FeedArticles_Handler,
 		},
+		{
+			MethodName: "ListMyDrafts",
+			Handler:    _Article_ListMyDrafts_Handler,
+		},
+		{
+			MethodName: "SearchArticles",
+			Handler:    _Article_SearchArticles_Handler,
+		},
 		{
 			MethodName: "GetArticle",
 			Handler:    _Article_GetArticle_Handler,
@@ -498,6 +1171,18 @@ var Article_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteComment",
 			Handler:    _Article_DeleteComment_Handler,
 		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _Article_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _Article_RestoreArticle_Handler,
+		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _Article_RestoreComment_Handler,
+		},
 		{
 			MethodName: "FavoriteArticle",
 			Handler:    _Article_FavoriteArticle_Handler,
@@ -510,6 +1195,62 @@ var Article_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTags",
 			Handler:    _Article_GetTags_Handler,
 		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _Article_CreateSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _Article_GetSeries_Handler,
+		},
+		{
+			MethodName: "UpdateSeries",
+			Handler:    _Article_UpdateSeries_Handler,
+		},
+		{
+			MethodName: "SetSeriesArticles",
+			Handler:    _Article_SetSeriesArticles_Handler,
+		},
+		{
+			MethodName: "DeleteSeries",
+			Handler:    _Article_DeleteSeries_Handler,
+		},
+		{
+			MethodName: "InviteCoAuthor",
+			Handler:    _Article_InviteCoAuthor_Handler,
+		},
+		{
+			MethodName: "AcceptCoAuthor",
+			Handler:    _Article_AcceptCoAuthor_Handler,
+		},
+		{
+			MethodName: "RemoveCoAuthor",
+			Handler:    _Article_RemoveCoAuthor_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _Article_ListInvitations_Handler,
+		},
+		{
+			MethodName: "RenderMarkdown",
+			Handler:    _Article_RenderMarkdown_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _Article_ListRevisions_Handler,
+		},
+		{
+			MethodName: "GetRevision",
+			Handler:    _Article_GetRevision_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _Article_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRevision",
+			Handler:    _Article_RestoreRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/article/v1/article.proto",
@@ -19,38 +19,97 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationArticleAcceptCoAuthor = "/article.v1.Article/AcceptCoAuthor"
 const OperationArticleAddComment = "/article.v1.Article/AddComment"
 const OperationArticleCreateArticle = "/article.v1.Article/CreateArticle"
+const OperationArticleCreateSeries = "/article.v1.Article/CreateSeries"
 const OperationArticleDeleteArticle = "/article.v1.Article/DeleteArticle"
 const OperationArticleDeleteComment = "/article.v1.Article/DeleteComment"
+const OperationArticleDeleteSeries = "/article.v1.Article/DeleteSeries"
+const OperationArticleDiffRevisions = "/article.v1.Article/DiffRevisions"
 const OperationArticleFavoriteArticle = "/article.v1.Article/FavoriteArticle"
 const OperationArticleFeedArticles = "/article.v1.Article/FeedArticles"
 const OperationArticleGetArticle = "/article.v1.Article/GetArticle"
 const OperationArticleGetComments = "/article.v1.Article/GetComments"
+const OperationArticleGetRevision = "/article.v1.Article/GetRevision"
+const OperationArticleGetSeries = "/article.v1.Article/GetSeries"
 const OperationArticleGetTags = "/article.v1.Article/GetTags"
+const OperationArticleInviteCoAuthor = "/article.v1.Article/InviteCoAuthor"
 const OperationArticleListArticles = "/article.v1.Article/ListArticles"
+const OperationArticleListInvitations = "/article.v1.Article/ListInvitations"
+const OperationArticleListMyDrafts = "/article.v1.Article/ListMyDrafts"
+const OperationArticleListRevisions = "/article.v1.Article/ListRevisions"
+const OperationArticleListTrash = "/article.v1.Article/ListTrash"
+const OperationArticleRemoveCoAuthor = "/article.v1.Article/RemoveCoAuthor"
+const OperationArticleRenderMarkdown = "/article.v1.Article/RenderMarkdown"
+const OperationArticleRestoreArticle = "/article.v1.Article/RestoreArticle"
+const OperationArticleRestoreComment = "/article.v1.Article/RestoreComment"
+const OperationArticleRestoreRevision = "/article.v1.Article/RestoreRevision"
+const OperationArticleSearchArticles = "/article.v1.Article/SearchArticles"
+const OperationArticleSetSeriesArticles = "/article.v1.Article/SetSeriesArticles"
 const OperationArticleUnfavoriteArticle = "/article.v1.Article/UnfavoriteArticle"
 const OperationArticleUpdateArticle = "/article.v1.Article/UpdateArticle"
+const OperationArticleUpdateSeries = "/article.v1.Article/UpdateSeries"
 
 type ArticleHTTPServer interface {
+	// Accepts the invitation of the current user to co-author an article.
+	AcceptCoAuthor(context.Context, *AcceptCoAuthorRequest) (*SingleArticleReply, error)
 	AddComment(context.Context, *AddCommentRequest) (*SingleCommentReply, error)
 	CreateArticle(context.Context, *CreateArticleRequest) (*SingleArticleReply, error)
+	// Series are ordered lists of articles of one author.
+	CreateSeries(context.Context, *CreateSeriesRequest) (*SingleSeriesReply, error)
 	DeleteArticle(context.Context, *DeleteArticleRequest) (*SingleArticleReply, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*SingleCommentReply, error)
+	// Deletes a series, its articles stay.
+	DeleteSeries(context.Context, *DeleteSeriesRequest) (*SingleSeriesReply, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*RevisionDiffReply, error)
 	FavoriteArticle(context.Context, *FavoriteArticleRequest) (*SingleArticleReply, error)
 	FeedArticles(context.Context, *FeedArticlesRequest) (*MultipleArticlesReply, error)
 	GetArticle(context.Context, *GetArticleRequest) (*SingleArticleReply, error)
-	GetComments(context.Context, *AddCommentRequest) (*MultipleCommentsReply, error)
+	GetComments(context.Context, *GetCommentsRequest) (*MultipleCommentsReply, error)
+	GetRevision(context.Context, *GetRevisionRequest) (*SingleRevisionReply, error)
+	GetSeries(context.Context, *GetSeriesRequest) (*SingleSeriesReply, error)
 	GetTags(context.Context, *GetTagsRequest) (*TagListReply, error)
+	// Invites a user to co-author an article. Only its owner may invite.
+	InviteCoAuthor(context.Context, *InviteCoAuthorRequest) (*SingleInvitationReply, error)
 	ListArticles(context.Context, *ListArticlesRequest) (*MultipleArticlesReply, error)
+	// Pending invitations of the current user, the latest first.
+	ListInvitations(context.Context, *ListInvitationsRequest) (*MultipleInvitationsReply, error)
+	// Draft and scheduled articles of the current user.
+	ListMyDrafts(context.Context, *ListMyDraftsRequest) (*MultipleArticlesReply, error)
+	// Revisions of an article, the latest first. Only its author can see them.
+	ListRevisions(context.Context, *ListRevisionsRequest) (*MultipleRevisionsReply, error)
+	// The deleted articles the current user owns and the comments they
+	//  deleted, until they are purged.
+	ListTrash(context.Context, *ListTrashRequest) (*TrashReply, error)
+	// Removes a co-author or withdraws an invitation. The owner may remove
+	//  anyone, other users only themselves.
+	RemoveCoAuthor(context.Context, *RemoveCoAuthorRequest) (*SingleArticleReply, error)
+	// Renders a Markdown body the way article bodies are rendered, for previews.
+	RenderMarkdown(context.Context, *RenderMarkdownRequest) (*RenderMarkdownReply, error)
+	// Takes an article of the current user out of the trash.
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*SingleArticleReply, error)
+	// Takes a comment of the current user out of the trash. Its article must
+	//  not be deleted.
+	RestoreComment(context.Context, *RestoreCommentRequest) (*SingleCommentReply, error)
+	// Makes a revision the current version of the article, as a new revision.
+	RestoreRevision(context.Context, *RestoreRevisionRequest) (*SingleArticleReply, error)
+	// Full-text search of the published articles, best matches first.
+	SearchArticles(context.Context, *SearchArticlesRequest) (*SearchArticlesReply, error)
+	// Replaces the articles of a series by the given ones, in order. This adds,
+	//  reorders and removes articles.
+	SetSeriesArticles(context.Context, *SetSeriesArticlesRequest) (*SingleSeriesReply, error)
 	UnfavoriteArticle(context.Context, *UnfavoriteArticleRequest) (*SingleArticleReply, error)
 	UpdateArticle(context.Context, *UpdateArticleRequest) (*SingleArticleReply, error)
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*SingleSeriesReply, error)
 }
 
 func RegisterArticleHTTPServer(s *http.Server, srv ArticleHTTPServer) {
 	r := s.Route("/")
 	r.GET("/api/articles", _Article_ListArticles0_HTTP_Handler(srv))
 	r.GET("/api/articles/feed", _Article_FeedArticles0_HTTP_Handler(srv))
+	r.GET("/api/articles/drafts", _Article_ListMyDrafts0_HTTP_Handler(srv))
+	r.GET("/api/articles/search", _Article_SearchArticles0_HTTP_Handler(srv))
 	r.GET("/api/articles/{slug}", _Article_GetArticle0_HTTP_Handler(srv))
 	r.POST("/api/articles", _Article_CreateArticle0_HTTP_Handler(srv))
 	r.PUT("/api/articles/{slug}", _Article_UpdateArticle0_HTTP_Handler(srv))
@@ -58,9 +117,26 @@ func RegisterArticleHTTPServer(s *http.Server, srv ArticleHTTPServer) {
 	r.POST("/api/articles/{slug}/comments", _Article_AddComment0_HTTP_Handler(srv))
 	r.GET("/api/articles/{slug}/comments", _Article_GetComments0_HTTP_Handler(srv))
 	r.DELETE("/api/articles/{slug}/comments/{id}", _Article_DeleteComment0_HTTP_Handler(srv))
+	r.GET("/api/trash", _Article_ListTrash0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/restore", _Article_RestoreArticle0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/comments/{id}/restore", _Article_RestoreComment0_HTTP_Handler(srv))
 	r.POST("/api/articles/{slug}/favorite", _Article_FavoriteArticle0_HTTP_Handler(srv))
 	r.DELETE("/api/articles/{slug}/favorite", _Article_UnfavoriteArticle0_HTTP_Handler(srv))
 	r.GET("/api/tags", _Article_GetTags0_HTTP_Handler(srv))
+	r.POST("/api/series", _Article_CreateSeries0_HTTP_Handler(srv))
+	r.GET("/api/series/{id}", _Article_GetSeries0_HTTP_Handler(srv))
+	r.PUT("/api/series/{id}", _Article_UpdateSeries0_HTTP_Handler(srv))
+	r.PUT("/api/series/{id}/articles", _Article_SetSeriesArticles0_HTTP_Handler(srv))
+	r.DELETE("/api/series/{id}", _Article_DeleteSeries0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/authors", _Article_InviteCoAuthor0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/authors/accept", _Article_AcceptCoAuthor0_HTTP_Handler(srv))
+	r.DELETE("/api/articles/{slug}/authors/{username}", _Article_RemoveCoAuthor0_HTTP_Handler(srv))
+	r.GET("/api/invitations", _Article_ListInvitations0_HTTP_Handler(srv))
+	r.POST("/api/render", _Article_RenderMarkdown0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/revisions", _Article_ListRevisions0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/revisions/{number}", _Article_GetRevision0_HTTP_Handler(srv))
+	r.GET("/api/articles/{slug}/revisions/{from}/diff/{to}", _Article_DiffRevisions0_HTTP_Handler(srv))
+	r.POST("/api/articles/{slug}/revisions/{number}/restore", _Article_RestoreRevision0_HTTP_Handler(srv))
 }
 
 func _Article_ListArticles0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
@@ -101,53 +177,483 @@ func _Article_FeedArticles0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Co
 	}
 }
 
+func _Article_ListMyDrafts0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyDraftsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleListMyDrafts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyDrafts(ctx, req.(*ListMyDraftsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_SearchArticles0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchArticlesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleSearchArticles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchArticles(ctx, req.(*SearchArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchArticlesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Article_GetArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetArticleRequest
+		var in GetArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleGetArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetArticle(ctx, req.(*GetArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_CreateArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleCreateArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateArticle(ctx, req.(*CreateArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_UpdateArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleUpdateArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateArticle(ctx, req.(*UpdateArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_DeleteArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleDeleteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteArticle(ctx, req.(*DeleteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_AddComment0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleAddComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AddComment(ctx, req.(*AddCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_GetComments0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCommentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleGetComments)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetComments(ctx, req.(*GetCommentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MultipleCommentsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_DeleteComment0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCommentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleDeleteComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_ListTrash0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTrashRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleListTrash)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTrash(ctx, req.(*ListTrashRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TrashReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_RestoreArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleRestoreArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreArticle(ctx, req.(*RestoreArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_RestoreComment0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreCommentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleRestoreComment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreComment(ctx, req.(*RestoreCommentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleCommentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_FavoriteArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FavoriteArticleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleFavoriteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_UnfavoriteArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnfavoriteArticleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleUnfavoriteArticle)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnfavoriteArticle(ctx, req.(*UnfavoriteArticleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleArticleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_GetTags0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTagsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleGetTags)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTags(ctx, req.(*GetTagsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TagListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_CreateSeries0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateSeriesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleCreateSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSeries(ctx, req.(*CreateSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_GetSeries0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSeriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleGetSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSeries(ctx, req.(*GetSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_UpdateSeries0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSeriesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleUpdateSeries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSeries(ctx, req.(*UpdateSeriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_SetSeriesArticles0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetSeriesArticlesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleSetSeriesArticles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetSeriesArticles(ctx, req.(*SetSeriesArticlesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SingleSeriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Article_DeleteSeries0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSeriesRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleGetArticle)
+		http.SetOperation(ctx, OperationArticleDeleteSeries)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetArticle(ctx, req.(*GetArticleRequest))
+			return srv.DeleteSeries(ctx, req.(*DeleteSeriesRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleReply)
+		reply := out.(*SingleSeriesReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Article_CreateArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_InviteCoAuthor0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CreateArticleRequest
+		var in InviteCoAuthorRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleCreateArticle)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleInviteCoAuthor)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateArticle(ctx, req.(*CreateArticleRequest))
+			return srv.InviteCoAuthor(ctx, req.(*InviteCoAuthorRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleReply)
+		reply := out.(*SingleInvitationReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Article_UpdateArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_AcceptCoAuthor0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UpdateArticleRequest
+		var in AcceptCoAuthorRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -157,9 +663,9 @@ func _Article_UpdateArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.C
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleUpdateArticle)
+		http.SetOperation(ctx, OperationArticleAcceptCoAuthor)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateArticle(ctx, req.(*UpdateArticleRequest))
+			return srv.AcceptCoAuthor(ctx, req.(*AcceptCoAuthorRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -170,18 +676,18 @@ func _Article_UpdateArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.C
 	}
 }
 
-func _Article_DeleteArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_RemoveCoAuthor0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteArticleRequest
+		var in RemoveCoAuthorRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleDeleteArticle)
+		http.SetOperation(ctx, OperationArticleRemoveCoAuthor)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteArticle(ctx, req.(*DeleteArticleRequest))
+			return srv.RemoveCoAuthor(ctx, req.(*RemoveCoAuthorRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -192,154 +698,170 @@ func _Article_DeleteArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.C
 	}
 }
 
-func _Article_AddComment0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_ListInvitations0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AddCommentRequest
-		if err := ctx.Bind(&in); err != nil {
-			return err
-		}
+		var in ListInvitationsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, OperationArticleAddComment)
+		http.SetOperation(ctx, OperationArticleListInvitations)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.AddComment(ctx, req.(*AddCommentRequest))
+			return srv.ListInvitations(ctx, req.(*ListInvitationsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleCommentReply)
+		reply := out.(*MultipleInvitationsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Article_GetComments0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_RenderMarkdown0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AddCommentRequest
-		if err := ctx.BindQuery(&in); err != nil {
+		var in RenderMarkdownRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleGetComments)
+		http.SetOperation(ctx, OperationArticleRenderMarkdown)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetComments(ctx, req.(*AddCommentRequest))
+			return srv.RenderMarkdown(ctx, req.(*RenderMarkdownRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*MultipleCommentsReply)
+		reply := out.(*RenderMarkdownReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Article_DeleteComment0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_ListRevisions0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteCommentRequest
+		var in ListRevisionsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleDeleteComment)
+		http.SetOperation(ctx, OperationArticleListRevisions)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteComment(ctx, req.(*DeleteCommentRequest))
+			return srv.ListRevisions(ctx, req.(*ListRevisionsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleCommentReply)
+		reply := out.(*MultipleRevisionsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Article_FavoriteArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_GetRevision0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in FavoriteArticleRequest
-		if err := ctx.Bind(&in); err != nil {
-			return err
-		}
+		var in GetRevisionRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleFavoriteArticle)
+		http.SetOperation(ctx, OperationArticleGetRevision)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.FavoriteArticle(ctx, req.(*FavoriteArticleRequest))
+			return srv.GetRevision(ctx, req.(*GetRevisionRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleReply)
+		reply := out.(*SingleRevisionReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Article_UnfavoriteArticle0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_DiffRevisions0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UnfavoriteArticleRequest
+		var in DiffRevisionsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleUnfavoriteArticle)
+		http.SetOperation(ctx, OperationArticleDiffRevisions)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UnfavoriteArticle(ctx, req.(*UnfavoriteArticleRequest))
+			return srv.DiffRevisions(ctx, req.(*DiffRevisionsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*SingleArticleReply)
+		reply := out.(*RevisionDiffReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _Article_GetTags0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
+func _Article_RestoreRevision0_HTTP_Handler(srv ArticleHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetTagsRequest
+		var in RestoreRevisionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationArticleGetTags)
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationArticleRestoreRevision)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetTags(ctx, req.(*GetTagsRequest))
+			return srv.RestoreRevision(ctx, req.(*RestoreRevisionRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*TagListReply)
+		reply := out.(*SingleArticleReply)
 		return ctx.Result(200, reply)
 	}
 }
 
 type ArticleHTTPClient interface {
+	AcceptCoAuthor(ctx context.Context, req *AcceptCoAuthorRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
 	AddComment(ctx context.Context, req *AddCommentRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
 	CreateArticle(ctx context.Context, req *CreateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	CreateSeries(ctx context.Context, req *CreateSeriesRequest, opts ...http.CallOption) (rsp *SingleSeriesReply, err error)
 	DeleteArticle(ctx context.Context, req *DeleteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
 	DeleteComment(ctx context.Context, req *DeleteCommentRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	DeleteSeries(ctx context.Context, req *DeleteSeriesRequest, opts ...http.CallOption) (rsp *SingleSeriesReply, err error)
+	DiffRevisions(ctx context.Context, req *DiffRevisionsRequest, opts ...http.CallOption) (rsp *RevisionDiffReply, err error)
 	FavoriteArticle(ctx context.Context, req *FavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
 	FeedArticles(ctx context.Context, req *FeedArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticlesReply, err error)
 	GetArticle(ctx context.Context, req *GetArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
-	GetComments(ctx context.Context, req *AddCommentRequest, opts ...http.CallOption) (rsp *MultipleCommentsReply, err error)
+	GetComments(ctx context.Context, req *GetCommentsRequest, opts ...http.CallOption) (rsp *MultipleCommentsReply, err error)
+	GetRevision(ctx context.Context, req *GetRevisionRequest, opts ...http.CallOption) (rsp *SingleRevisionReply, err error)
+	GetSeries(ctx context.Context, req *GetSeriesRequest, opts ...http.CallOption) (rsp *SingleSeriesReply, err error)
 	GetTags(ctx context.Context, req *GetTagsRequest, opts ...http.CallOption) (rsp *TagListReply, err error)
+	InviteCoAuthor(ctx context.Context, req *InviteCoAuthorRequest, opts ...http.CallOption) (rsp *SingleInvitationReply, err error)
 	ListArticles(ctx context.Context, req *ListArticlesRequest, opts ...http.CallOption) (rsp *MultipleArticlesReply, err error)
+	ListInvitations(ctx context.Context, req *ListInvitationsRequest, opts ...http.CallOption) (rsp *MultipleInvitationsReply, err error)
+	ListMyDrafts(ctx context.Context, req *ListMyDraftsRequest, opts ...http.CallOption) (rsp *MultipleArticlesReply, err error)
+	ListRevisions(ctx context.Context, req *ListRevisionsRequest, opts ...http.CallOption) (rsp *MultipleRevisionsReply, err error)
+	ListTrash(ctx context.Context, req *ListTrashRequest, opts ...http.CallOption) (rsp *TrashReply, err error)
+	RemoveCoAuthor(ctx context.Context, req *RemoveCoAuthorRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	RenderMarkdown(ctx context.Context, req *RenderMarkdownRequest, opts ...http.CallOption) (rsp *RenderMarkdownReply, err error)
+	RestoreArticle(ctx context.Context, req *RestoreArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	RestoreComment(ctx context.Context, req *RestoreCommentRequest, opts ...http.CallOption) (rsp *SingleCommentReply, err error)
+	RestoreRevision(ctx context.Context, req *RestoreRevisionRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	SearchArticles(ctx context.Context, req *SearchArticlesRequest, opts ...http.CallOption) (rsp *SearchArticlesReply, err error)
+	SetSeriesArticles(ctx context.Context, req *SetSeriesArticlesRequest, opts ...http.CallOption) (rsp *SingleSeriesReply, err error)
 	UnfavoriteArticle(ctx context.Context, req *UnfavoriteArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
 	UpdateArticle(ctx context.Context, req *UpdateArticleRequest, opts ...http.CallOption) (rsp *SingleArticleReply, err error)
+	UpdateSeries(ctx context.Context, req *UpdateSeriesRequest, opts ...http.CallOption) (rsp *SingleSeriesReply, err error)
 }
 
 type ArticleHTTPClientImpl struct {
@@ -350,6 +872,19 @@ func NewArticleHTTPClient(client *http.Client) ArticleHTTPClient {
 	return &ArticleHTTPClientImpl{client}
 }
 
+func (c *ArticleHTTPClientImpl) AcceptCoAuthor(ctx context.Context, in *AcceptCoAuthorRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/authors/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleAcceptCoAuthor))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ArticleHTTPClientImpl) AddComment(ctx context.Context, in *AddCommentRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
 	var out SingleCommentReply
 	pattern := "/api/articles/{slug}/comments"
@@ -376,6 +911,19 @@ func (c *ArticleHTTPClientImpl) CreateArticle(ctx context.Context, in *CreateArt
 	return &out, err
 }
 
+func (c *ArticleHTTPClientImpl) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...http.CallOption) (*SingleSeriesReply, error) {
+	var out SingleSeriesReply
+	pattern := "/api/series"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleCreateSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ArticleHTTPClientImpl) DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
 	var out SingleArticleReply
 	pattern := "/api/articles/{slug}"
@@ -402,6 +950,32 @@ func (c *ArticleHTTPClientImpl) DeleteComment(ctx context.Context, in *DeleteCom
 	return &out, err
 }
 
+func (c *ArticleHTTPClientImpl) DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...http.CallOption) (*SingleSeriesReply, error) {
+	var out SingleSeriesReply
+	pattern := "/api/series/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleDeleteSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...http.CallOption) (*RevisionDiffReply, error) {
+	var out RevisionDiffReply
+	pattern := "/api/articles/{slug}/revisions/{from}/diff/{to}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleDiffRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ArticleHTTPClientImpl) FavoriteArticle(ctx context.Context, in *FavoriteArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
 	var out SingleArticleReply
 	pattern := "/api/articles/{slug}/favorite"
@@ -441,7 +1015,7 @@ func (c *ArticleHTTPClientImpl) GetArticle(ctx context.Context, in *GetArticleRe
 	return &out, err
 }
 
-func (c *ArticleHTTPClientImpl) GetComments(ctx context.Context, in *AddCommentRequest, opts ...http.CallOption) (*MultipleCommentsReply, error) {
+func (c *ArticleHTTPClientImpl) GetComments(ctx context.Context, in *GetCommentsRequest, opts ...http.CallOption) (*MultipleCommentsReply, error) {
 	var out MultipleCommentsReply
 	pattern := "/api/articles/{slug}/comments"
 	path := binding.EncodeURL(pattern, in, true)
@@ -454,6 +1028,32 @@ func (c *ArticleHTTPClientImpl) GetComments(ctx context.Context, in *AddCommentR
 	return &out, err
 }
 
+func (c *ArticleHTTPClientImpl) GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...http.CallOption) (*SingleRevisionReply, error) {
+	var out SingleRevisionReply
+	pattern := "/api/articles/{slug}/revisions/{number}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleGetRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...http.CallOption) (*SingleSeriesReply, error) {
+	var out SingleSeriesReply
+	pattern := "/api/series/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleGetSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ArticleHTTPClientImpl) GetTags(ctx context.Context, in *GetTagsRequest, opts ...http.CallOption) (*TagListReply, error) {
 	var out TagListReply
 	pattern := "/api/tags"
@@ -467,6 +1067,19 @@ func (c *ArticleHTTPClientImpl) GetTags(ctx context.Context, in *GetTagsRequest,
 	return &out, err
 }
 
+func (c *ArticleHTTPClientImpl) InviteCoAuthor(ctx context.Context, in *InviteCoAuthorRequest, opts ...http.CallOption) (*SingleInvitationReply, error) {
+	var out SingleInvitationReply
+	pattern := "/api/articles/{slug}/authors"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleInviteCoAuthor))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ArticleHTTPClientImpl) ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...http.CallOption) (*MultipleArticlesReply, error) {
 	var out MultipleArticlesReply
 	pattern := "/api/articles"
@@ -480,6 +1093,149 @@ func (c *ArticleHTTPClientImpl) ListArticles(ctx context.Context, in *ListArticl
 	return &out, err
 }
 
+func (c *ArticleHTTPClientImpl) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...http.CallOption) (*MultipleInvitationsReply, error) {
+	var out MultipleInvitationsReply
+	pattern := "/api/invitations"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleListInvitations))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) ListMyDrafts(ctx context.Context, in *ListMyDraftsRequest, opts ...http.CallOption) (*MultipleArticlesReply, error) {
+	var out MultipleArticlesReply
+	pattern := "/api/articles/drafts"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleListMyDrafts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...http.CallOption) (*MultipleRevisionsReply, error) {
+	var out MultipleRevisionsReply
+	pattern := "/api/articles/{slug}/revisions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleListRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...http.CallOption) (*TrashReply, error) {
+	var out TrashReply
+	pattern := "/api/trash"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleListTrash))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) RemoveCoAuthor(ctx context.Context, in *RemoveCoAuthorRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/authors/{username}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleRemoveCoAuthor))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) RenderMarkdown(ctx context.Context, in *RenderMarkdownRequest, opts ...http.CallOption) (*RenderMarkdownReply, error) {
+	var out RenderMarkdownReply
+	pattern := "/api/render"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleRenderMarkdown))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleRestoreArticle))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) RestoreComment(ctx context.Context, in *RestoreCommentRequest, opts ...http.CallOption) (*SingleCommentReply, error) {
+	var out SingleCommentReply
+	pattern := "/api/articles/{slug}/comments/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleRestoreComment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) RestoreRevision(ctx context.Context, in *RestoreRevisionRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
+	var out SingleArticleReply
+	pattern := "/api/articles/{slug}/revisions/{number}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleRestoreRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) SearchArticles(ctx context.Context, in *SearchArticlesRequest, opts ...http.CallOption) (*SearchArticlesReply, error) {
+	var out SearchArticlesReply
+	pattern := "/api/articles/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationArticleSearchArticles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ArticleHTTPClientImpl) SetSeriesArticles(ctx context.Context, in *SetSeriesArticlesRequest, opts ...http.CallOption) (*SingleSeriesReply, error) {
+	var out SingleSeriesReply
+	pattern := "/api/series/{id}/articles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleSetSeriesArticles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ArticleHTTPClientImpl) UnfavoriteArticle(ctx context.Context, in *UnfavoriteArticleRequest, opts ...http.CallOption) (*SingleArticleReply, error) {
 	var out SingleArticleReply
 	pattern := "/api/articles/{slug}/favorite"
@@ -505,3 +1261,16 @@ func (c *ArticleHTTPClientImpl) UpdateArticle(ctx context.Context, in *UpdateArt
 	}
 	return &out, err
 }
+
+func (c *ArticleHTTPClientImpl) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...http.CallOption) (*SingleSeriesReply, error) {
+	var out SingleSeriesReply
+	pattern := "/api/series/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationArticleUpdateSeries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
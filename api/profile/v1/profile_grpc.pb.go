@@ -19,9 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Profile_GetProfile_FullMethodName   = "/profile.v1.Profile/GetProfile"
-	Profile_FollowUser_FullMethodName   = "/profile.v1.Profile/FollowUser"
-	Profile_UnfollowUser_FullMethodName = "/profile.v1.Profile/UnfollowUser"
+	Profile_GetProfile_FullMethodName           = "/profile.v1.Profile/GetProfile"
+	Profile_FollowUser_FullMethodName           = "/profile.v1.Profile/FollowUser"
+	Profile_UnfollowUser_FullMethodName         = "/profile.v1.Profile/UnfollowUser"
+	Profile_ListFollowers_FullMethodName        = "/profile.v1.Profile/ListFollowers"
+	Profile_ListFollowing_FullMethodName        = "/profile.v1.Profile/ListFollowing"
+	Profile_BlockUser_FullMethodName            = "/profile.v1.Profile/BlockUser"
+	Profile_UnblockUser_FullMethodName          = "/profile.v1.Profile/UnblockUser"
+	Profile_MuteUser_FullMethodName             = "/profile.v1.Profile/MuteUser"
+	Profile_UnmuteUser_FullMethodName           = "/profile.v1.Profile/UnmuteUser"
+	Profile_ListFollowRequests_FullMethodName   = "/profile.v1.Profile/ListFollowRequests"
+	Profile_ApproveFollowRequest_FullMethodName = "/profile.v1.Profile/ApproveFollowRequest"
+	Profile_DenyFollowRequest_FullMethodName    = "/profile.v1.Profile/DenyFollowRequest"
 )
 
 // ProfileClient is the client API for Profile service.
@@ -31,6 +40,23 @@ type ProfileClient interface {
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*ProfileReply, error)
 	FollowUser(ctx context.Context, in *FollowUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
 	UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
+	// Users following username, the latest first.
+	ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ProfilesReply, error)
+	// Users username follows, the latest first.
+	ListFollowing(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ProfilesReply, error)
+	// Blocking ends the follows between the users and keeps username from
+	// seeing the profile of the current user or acting on their articles.
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
+	// Muting hides the articles and comments of username from the current
+	// user.
+	MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
+	UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*ProfileReply, error)
+	// Users asking to follow the current user, the latest first.
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ProfilesReply, error)
+	// Approving lets username follow the current user.
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ProfileReply, error)
+	DenyFollowRequest(ctx context.Context, in *DenyFollowRequestRequest, opts ...grpc.CallOption) (*ProfileReply, error)
 }
 
 type profileClient struct {
@@ -68,6 +94,87 @@ func (c *profileClient) UnfollowUser(ctx context.Context, in *UnfollowUserReques
 	return out, nil
 }
 
+func (c *profileClient) ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ProfilesReply, error) {
+	out := new(ProfilesReply)
+	err := c.cc.Invoke(ctx, Profile_ListFollowers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) ListFollowing(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ProfilesReply, error) {
+	out := new(ProfilesReply)
+	err := c.cc.Invoke(ctx, Profile_ListFollowing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*ProfileReply, error) {
+	out := new(ProfileReply)
+	err := c.cc.Invoke(ctx, Profile_BlockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*ProfileReply, error) {
+	out := new(ProfileReply)
+	err := c.cc.Invoke(ctx, Profile_UnblockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*ProfileReply, error) {
+	out := new(ProfileReply)
+	err := c.cc.Invoke(ctx, Profile_MuteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*ProfileReply, error) {
+	out := new(ProfileReply)
+	err := c.cc.Invoke(ctx, Profile_UnmuteUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ProfilesReply, error) {
+	out := new(ProfilesReply)
+	err := c.cc.Invoke(ctx, Profile_ListFollowRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ProfileReply, error) {
+	out := new(ProfileReply)
+	err := c.cc.Invoke(ctx, Profile_ApproveFollowRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) DenyFollowRequest(ctx context.Context, in *DenyFollowRequestRequest, opts ...grpc.CallOption) (*ProfileReply, error) {
+	out := new(ProfileReply)
+	err := c.cc.Invoke(ctx, Profile_DenyFollowRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServer is the server API for Profile service.
 // All implementations must embed UnimplementedProfileServer
 // for forward compatibility
@@ -75,6 +182,23 @@ type ProfileServer interface {
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileReply, error)
 	FollowUser(context.Context, *FollowUserRequest) (*ProfileReply, error)
 	UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileReply, error)
+	// Users following username, the latest first.
+	ListFollowers(context.Context, *ListFollowsRequest) (*ProfilesReply, error)
+	// Users username follows, the latest first.
+	ListFollowing(context.Context, *ListFollowsRequest) (*ProfilesReply, error)
+	// Blocking ends the follows between the users and keeps username from
+	// seeing the profile of the current user or acting on their articles.
+	BlockUser(context.Context, *BlockUserRequest) (*ProfileReply, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*ProfileReply, error)
+	// Muting hides the articles and comments of username from the current
+	// user.
+	MuteUser(context.Context, *MuteUserRequest) (*ProfileReply, error)
+	UnmuteUser(context.Context, *UnmuteUserRequest) (*ProfileReply, error)
+	// Users asking to follow the current user, the latest first.
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ProfilesReply, error)
+	// Approving lets username follow the current user.
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ProfileReply, error)
+	DenyFollowRequest(context.Context, *DenyFollowRequestRequest) (*ProfileReply, error)
 	mustEmbedUnimplementedProfileServer()
 }
 
@@ -91,6 +215,33 @@ func (UnimplementedProfileServer) FollowUser(context.Context, *FollowUserRequest
 func (UnimplementedProfileServer) UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
 }
+func (UnimplementedProfileServer) ListFollowers(context.Context, *ListFollowsRequest) (*ProfilesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedProfileServer) ListFollowing(context.Context, *ListFollowsRequest) (*ProfilesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedProfileServer) BlockUser(context.Context, *BlockUserRequest) (*ProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedProfileServer) UnblockUser(context.Context, *UnblockUserRequest) (*ProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedProfileServer) MuteUser(context.Context, *MuteUserRequest) (*ProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedProfileServer) UnmuteUser(context.Context, *UnmuteUserRequest) (*ProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (UnimplementedProfileServer) ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ProfilesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedProfileServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedProfileServer) DenyFollowRequest(context.Context, *DenyFollowRequestRequest) (*ProfileReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyFollowRequest not implemented")
+}
 func (UnimplementedProfileServer) mustEmbedUnimplementedProfileServer() {}
 
 // UnsafeProfileServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +309,168 @@ func _Profile_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Profile_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).ListFollowers(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_ListFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).ListFollowing(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_MuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).MuteUser(ctx, req.(*MuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_UnmuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).UnmuteUser(ctx, req.(*UnmuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).ListFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_ListFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_ApproveFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_DenyFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).DenyFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profile_DenyFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).DenyFollowRequest(ctx, req.(*DenyFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Profile_ServiceDesc is the grpc.ServiceDesc for Profile service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +490,42 @@ var Profile_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfollowUser",
 			Handler:    _Profile_UnfollowUser_Handler,
 		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _Profile_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _Profile_ListFollowing_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Profile_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Profile_UnblockUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _Profile_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _Profile_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _Profile_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _Profile_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "DenyFollowRequest",
+			Handler:    _Profile_DenyFollowRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/profile/v1/profile.proto",
@@ -19,14 +19,40 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationProfileApproveFollowRequest = "/profile.v1.Profile/ApproveFollowRequest"
+const OperationProfileBlockUser = "/profile.v1.Profile/BlockUser"
+const OperationProfileDenyFollowRequest = "/profile.v1.Profile/DenyFollowRequest"
 const OperationProfileFollowUser = "/profile.v1.Profile/FollowUser"
 const OperationProfileGetProfile = "/profile.v1.Profile/GetProfile"
+const OperationProfileListFollowRequests = "/profile.v1.Profile/ListFollowRequests"
+const OperationProfileListFollowers = "/profile.v1.Profile/ListFollowers"
+const OperationProfileListFollowing = "/profile.v1.Profile/ListFollowing"
+const OperationProfileMuteUser = "/profile.v1.Profile/MuteUser"
+const OperationProfileUnblockUser = "/profile.v1.Profile/UnblockUser"
 const OperationProfileUnfollowUser = "/profile.v1.Profile/UnfollowUser"
+const OperationProfileUnmuteUser = "/profile.v1.Profile/UnmuteUser"
 
 type ProfileHTTPServer interface {
+	// Approving lets username follow the current user.
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ProfileReply, error)
+	// Blocking ends the follows between the users and keeps username from
+	//  seeing the profile of the current user or acting on their articles.
+	BlockUser(context.Context, *BlockUserRequest) (*ProfileReply, error)
+	DenyFollowRequest(context.Context, *DenyFollowRequestRequest) (*ProfileReply, error)
 	FollowUser(context.Context, *FollowUserRequest) (*ProfileReply, error)
 	GetProfile(context.Context, *GetProfileRequest) (*ProfileReply, error)
+	// Users asking to follow the current user, the latest first.
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ProfilesReply, error)
+	// Users following username, the latest first.
+	ListFollowers(context.Context, *ListFollowsRequest) (*ProfilesReply, error)
+	// Users username follows, the latest first.
+	ListFollowing(context.Context, *ListFollowsRequest) (*ProfilesReply, error)
+	// Muting hides the articles and comments of username from the current
+	//  user.
+	MuteUser(context.Context, *MuteUserRequest) (*ProfileReply, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*ProfileReply, error)
 	UnfollowUser(context.Context, *UnfollowUserRequest) (*ProfileReply, error)
+	UnmuteUser(context.Context, *UnmuteUserRequest) (*ProfileReply, error)
 }
 
 func RegisterProfileHTTPServer(s *http.Server, srv ProfileHTTPServer) {
@@ -34,6 +60,15 @@ func RegisterProfileHTTPServer(s *http.Server, srv ProfileHTTPServer) {
 	r.GET("/api/profiles/{username}", _Profile_GetProfile0_HTTP_Handler(srv))
 	r.POST("/api/profiles/{username}/follow", _Profile_FollowUser0_HTTP_Handler(srv))
 	r.DELETE("/api/profiles/{username}/follow", _Profile_UnfollowUser0_HTTP_Handler(srv))
+	r.GET("/api/profiles/{username}/followers", _Profile_ListFollowers0_HTTP_Handler(srv))
+	r.GET("/api/profiles/{username}/following", _Profile_ListFollowing0_HTTP_Handler(srv))
+	r.POST("/api/profiles/{username}/block", _Profile_BlockUser0_HTTP_Handler(srv))
+	r.DELETE("/api/profiles/{username}/block", _Profile_UnblockUser0_HTTP_Handler(srv))
+	r.POST("/api/profiles/{username}/mute", _Profile_MuteUser0_HTTP_Handler(srv))
+	r.DELETE("/api/profiles/{username}/mute", _Profile_UnmuteUser0_HTTP_Handler(srv))
+	r.GET("/api/follow-requests", _Profile_ListFollowRequests0_HTTP_Handler(srv))
+	r.POST("/api/follow-requests/{username}", _Profile_ApproveFollowRequest0_HTTP_Handler(srv))
+	r.DELETE("/api/follow-requests/{username}", _Profile_DenyFollowRequest0_HTTP_Handler(srv))
 }
 
 func _Profile_GetProfile0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
@@ -105,10 +140,223 @@ func _Profile_UnfollowUser0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Co
 	}
 }
 
+func _Profile_ListFollowers0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileListFollowers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowers(ctx, req.(*ListFollowsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfilesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_ListFollowing0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileListFollowing)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowing(ctx, req.(*ListFollowsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfilesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_BlockUser0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileBlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BlockUser(ctx, req.(*BlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_UnblockUser0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnblockUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileUnblockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnblockUser(ctx, req.(*UnblockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_MuteUser0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MuteUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileMuteUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MuteUser(ctx, req.(*MuteUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_UnmuteUser0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnmuteUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileUnmuteUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnmuteUser(ctx, req.(*UnmuteUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_ListFollowRequests0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListFollowRequestsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileListFollowRequests)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfilesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_ApproveFollowRequest0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ApproveFollowRequestRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileApproveFollowRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Profile_DenyFollowRequest0_HTTP_Handler(srv ProfileHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DenyFollowRequestRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationProfileDenyFollowRequest)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DenyFollowRequest(ctx, req.(*DenyFollowRequestRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ProfileReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ProfileHTTPClient interface {
+	ApproveFollowRequest(ctx context.Context, req *ApproveFollowRequestRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	BlockUser(ctx context.Context, req *BlockUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	DenyFollowRequest(ctx context.Context, req *DenyFollowRequestRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
 	FollowUser(ctx context.Context, req *FollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
 	GetProfile(ctx context.Context, req *GetProfileRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	ListFollowRequests(ctx context.Context, req *ListFollowRequestsRequest, opts ...http.CallOption) (rsp *ProfilesReply, err error)
+	ListFollowers(ctx context.Context, req *ListFollowsRequest, opts ...http.CallOption) (rsp *ProfilesReply, err error)
+	ListFollowing(ctx context.Context, req *ListFollowsRequest, opts ...http.CallOption) (rsp *ProfilesReply, err error)
+	MuteUser(ctx context.Context, req *MuteUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	UnblockUser(ctx context.Context, req *UnblockUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
 	UnfollowUser(ctx context.Context, req *UnfollowUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
+	UnmuteUser(ctx context.Context, req *UnmuteUserRequest, opts ...http.CallOption) (rsp *ProfileReply, err error)
 }
 
 type ProfileHTTPClientImpl struct {
@@ -119,6 +367,45 @@ func NewProfileHTTPClient(client *http.Client) ProfileHTTPClient {
 	return &ProfileHTTPClientImpl{client}
 }
 
+func (c *ProfileHTTPClientImpl) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...http.CallOption) (*ProfileReply, error) {
+	var out ProfileReply
+	pattern := "/api/follow-requests/{username}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProfileApproveFollowRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ProfileHTTPClientImpl) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...http.CallOption) (*ProfileReply, error) {
+	var out ProfileReply
+	pattern := "/api/profiles/{username}/block"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProfileBlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ProfileHTTPClientImpl) DenyFollowRequest(ctx context.Context, in *DenyFollowRequestRequest, opts ...http.CallOption) (*ProfileReply, error) {
+	var out ProfileReply
+	pattern := "/api/follow-requests/{username}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProfileDenyFollowRequest))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ProfileHTTPClientImpl) FollowUser(ctx context.Context, in *FollowUserRequest, opts ...http.CallOption) (*ProfileReply, error) {
 	var out ProfileReply
 	pattern := "/api/profiles/{username}/follow"
@@ -145,6 +432,71 @@ func (c *ProfileHTTPClientImpl) GetProfile(ctx context.Context, in *GetProfileRe
 	return &out, err
 }
 
+func (c *ProfileHTTPClientImpl) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...http.CallOption) (*ProfilesReply, error) {
+	var out ProfilesReply
+	pattern := "/api/follow-requests"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProfileListFollowRequests))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ProfileHTTPClientImpl) ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...http.CallOption) (*ProfilesReply, error) {
+	var out ProfilesReply
+	pattern := "/api/profiles/{username}/followers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProfileListFollowers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ProfileHTTPClientImpl) ListFollowing(ctx context.Context, in *ListFollowsRequest, opts ...http.CallOption) (*ProfilesReply, error) {
+	var out ProfilesReply
+	pattern := "/api/profiles/{username}/following"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProfileListFollowing))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ProfileHTTPClientImpl) MuteUser(ctx context.Context, in *MuteUserRequest, opts ...http.CallOption) (*ProfileReply, error) {
+	var out ProfileReply
+	pattern := "/api/profiles/{username}/mute"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationProfileMuteUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c *ProfileHTTPClientImpl) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...http.CallOption) (*ProfileReply, error) {
+	var out ProfileReply
+	pattern := "/api/profiles/{username}/block"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProfileUnblockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
 func (c *ProfileHTTPClientImpl) UnfollowUser(ctx context.Context, in *UnfollowUserRequest, opts ...http.CallOption) (*ProfileReply, error) {
 	var out ProfileReply
 	pattern := "/api/profiles/{username}/follow"
@@ -157,3 +509,16 @@ func (c *ProfileHTTPClientImpl) UnfollowUser(ctx context.Context, in *UnfollowUs
 	}
 	return &out, err
 }
+
+func (c *ProfileHTTPClientImpl) UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...http.CallOption) (*ProfileReply, error) {
+	var out ProfileReply
+	pattern := "/api/profiles/{username}/mute"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationProfileUnmuteUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
 
 	"realworld/internal/conf"
+	"realworld/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -31,9 +33,13 @@ var (
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [migrate up|down|status|create <name>]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, sc *server.Scheduler) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,12 +49,39 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			sc,
+		),
+	)
+}
+
+func loadBootstrap() (*conf.Bootstrap, func(), error) {
+	c := config.New(
+		config.WithSource(
+			file.NewSource(flagconf),
 		),
 	)
+	if err := c.Load(); err != nil {
+		c.Close()
+		return nil, nil, err
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		c.Close()
+		return nil, nil, err
+	}
+	return &bc, func() { c.Close() }, nil
 }
 
 func main() {
 	flag.Parse()
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(flag.Args()[1:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	logger := log.With(log.NewStdLogger(os.Stdout),
 		"ts", log.DefaultTimestamp,
 		"caller", log.DefaultCaller,
@@ -58,21 +91,11 @@ func main() {
 		"trace.id", tracing.TraceID(),
 		"span.id", tracing.SpanID(),
 	)
-	c := config.New(
-		config.WithSource(
-			file.NewSource(flagconf),
-		),
-	)
-	defer c.Close()
-
-	if err := c.Load(); err != nil {
-		panic(err)
-	}
-
-	var bc conf.Bootstrap
-	if err := c.Scan(&bc); err != nil {
+	bc, closeConfig, err := loadBootstrap()
+	if err != nil {
 		panic(err)
 	}
+	defer closeConfig()
 
 	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Jwt, logger)
 	if err != nil {
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"realworld/internal/data"
+)
+
+// runMigrate implements `realworld migrate up|down|status|create <name>`.
+func runMigrate(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dir := fs.String("dir", data.MigrationsDir, "migrations source directory, used by create")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: realworld [-conf path] migrate [-dir path] up|down|status|create <name>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	switch fs.Arg(0) {
+	case "create":
+		files, err := data.CreateMigration(*dir, fs.Arg(1))
+		for _, f := range files {
+			fmt.Println("created", f)
+		}
+		return err
+	case "up", "down", "status":
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	bc, closeConfig, err := loadBootstrap()
+	if err != nil {
+		return err
+	}
+	defer closeConfig()
+	db, err := data.OpenDb(bc.Data)
+	if err != nil {
+		return err
+	}
+	m, err := data.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch fs.Arg(0) {
+	case "up":
+		applied, err := m.Up()
+		for _, x := range applied {
+			fmt.Printf("applied %04d_%s\n", x.Version, x.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	case "down":
+		x, err := m.Down()
+		if x != nil {
+			fmt.Printf("rolled back %04d_%s\n", x.Version, x.Name)
+		} else if err == nil {
+			fmt.Println("no migrations to roll back")
+		}
+		return err
+	}
+
+	status, err := m.Status()
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+	for _, s := range status {
+		at := "pending"
+		if s.AppliedAt != nil {
+			at = s.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, at)
+	}
+	return w.Flush()
+}
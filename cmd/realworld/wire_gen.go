@@ -24,25 +24,38 @@ import (
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, jwt *conf.JWT, logger log.Logger) (*kratos.App, func(), error) {
-	db := data.NewMysqlDb(confData, logger)
+	db := data.NewDb(confData, logger)
 	dataData, cleanup, err := data.NewData(db, confData, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	userRepo := data.NewUserRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, logger, jwt)
+	transaction := data.NewTransaction(dataData)
+	userUsecase := biz.NewUserUsecase(userRepo, transaction, logger, jwt)
 	userService := service.NewUserService(userUsecase)
 	profileRepo := data.NewProfileRepo(dataData, logger)
-	profileUsecase := biz.NewProfileUsecase(profileRepo, logger)
+	timelineRepo := data.NewTimelineRepo(confData, dataData, logger)
+	profileUsecase := biz.NewProfileUsecase(profileRepo, timelineRepo, logger)
 	profileService := service.NewProfileService(profileUsecase)
 	articleRepo := data.NewArticleRepo(dataData, logger)
 	commentRepo := data.NewCommentRepo(dataData, logger)
-	socialUsecase := biz.NewSocialUsecase(articleRepo, profileRepo, commentRepo, logger)
+	revisionRepo := data.NewRevisionRepo(dataData, logger)
+	seriesRepo := data.NewSeriesRepo(dataData, logger)
+	coAuthorRepo := data.NewCoAuthorRepo(dataData, logger)
+	trashRepo := data.NewTrashRepo(dataData, logger)
+	searchIndex, cleanup2, err := data.NewSearchIndex(confData, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	socialUsecase := biz.NewSocialUsecase(articleRepo, profileRepo, commentRepo, revisionRepo, seriesRepo, coAuthorRepo, trashRepo, timelineRepo, searchIndex, transaction, logger)
 	articleService := service.NewArticleService(socialUsecase)
 	grpcServer := server.NewGRPCServer(confServer, userService, profileService, articleService, logger)
-	httpServer := server.NewHTTPServer(confServer, userService, profileService, articleService, jwt, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	httpServer := server.NewHTTPServer(confServer, userService, profileService, articleService, jwt, dataData, logger)
+	scheduler := server.NewScheduler(confServer, socialUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, scheduler)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
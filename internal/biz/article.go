@@ -2,6 +2,9 @@ package biz
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"realworld/pkg/middleware/auth"
@@ -23,53 +26,88 @@ func NewArticleUsecase(repo ArticleRepo, logger log.Logger) *ArticleUsecase {
 }
 
 type ArticleRepo interface {
-	List(ctx context.Context, los ListOptions, opts ...DbOption) ([]*Article, int64, error)
+	List(ctx context.Context, q ArticleQuery) ([]*Article, int64, error)
+	// Get returns the article with slug, which may also be a slug the article
+	// had before its title changed.
 	Get(ctx context.Context, slug string) (*Article, error)
+	// SlugOwner returns the id of the article that has or had slug, deleted
+	// ones included, or 0 when no article ever had it.
+	SlugOwner(ctx context.Context, slug string) (uint, error)
+	// TitleTaken reports whether an article other than except has title,
+	// deleted ones included.
+	TitleTaken(ctx context.Context, title string, except uint) (bool, error)
 	Create(ctx context.Context, a *Article) (*Article, error)
 	Update(ctx context.Context, a *Article) (*Article, error)
+	// Restore is Update that also writes an empty description or body, as
+	// the revision being restored has them.
+	Restore(ctx context.Context, a *Article) (*Article, error)
 	Delete(ctx context.Context, a *Article) error
 	GetArticle(ctx context.Context, aid uint) (*Article, error)
-	CheckFavorited(uid uint, id uint) bool
+	CheckFavorited(ctx context.Context, uid uint, id uint) bool
 	Favorite(ctx context.Context, currentUserID uint, aid uint) error
 	Unfavorite(ctx context.Context, currentUserID uint, aid uint) error
 	GetFavoritesStatus(ctx context.Context, currentUserID uint, as []*Article) (favorited []bool, err error)
 
 	ListTags(ctx context.Context) ([]Tag, error)
+	// PublishDue publishes the scheduled articles whose PublishAt is not after
+	// now and returns them. An article is returned by one call only.
+	PublishDue(ctx context.Context, now time.Time) ([]*Article, error)
 }
 
 type CommentRepo interface {
 	Create(ctx context.Context, c *Comment) (*Comment, error)
 	Get(ctx context.Context, id uint) (*Comment, error)
-	List(ctx context.Context, slug string) ([]*Comment, error)
+	// List returns the comments of q in id order.
+	List(ctx context.Context, q CommentQuery) ([]*Comment, error)
 	Delete(ctx context.Context, id uint) error
 }
 
 type SocialUsecase struct {
-	ar ArticleRepo
-	cr CommentRepo
-	pr ProfileRepo
+	ar  ArticleRepo
+	cr  CommentRepo
+	pr  ProfileRepo
+	rr  RevisionRepo
+	sr  SeriesRepo
+	car CoAuthorRepo
+	tr  TrashRepo
+	tl  TimelineRepo
+	si  SearchIndex
+	tx  Transaction
 
 	log *log.Helper
 }
 
 type Article struct {
-	ID             uint
-	Slug           string
-	Title          string
-	Description    string
-	Body           string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	ID          uint
+	Slug        string
+	Title       string
+	Description string
+	Body        string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// DeletedAt is set for articles in the trash.
+	DeletedAt      time.Time
 	TagList        []string
 	Favorited      bool
 	FavoritesCount uint32
-
+	Status         ArticleStatus
+	// PublishAt is when the article was or will be published, zero for drafts.
+	PublishAt time.Time
+	// Rendering is set from Body when the article is saved.
+	Rendering *Rendering
+	// Series is set by GetArticle, nil when the article is in none.
+	Series *ArticleSeries
+	// SortKey is set by ArticleRepo.List for the keyed sorts, see
+	// ArticleQuery.KeyedSort.
+	SortKey int64
+
+	// AuthorUserID is the owner of the article, CoAuthorIDs the users who
+	// accepted to co-author it.
 	AuthorUserID uint
+	CoAuthorIDs  []uint
 
-	Author   *Profile
-	Username string
-	Email    string
-	Image    string
+	Author    *Profile
+	CoAuthors []*Profile
 }
 
 type Comment struct {
@@ -77,6 +115,8 @@ type Comment struct {
 	Body      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// DeletedAt is set for comments in the trash.
+	DeletedAt time.Time
 
 	ArticleID uint
 
@@ -87,13 +127,84 @@ type Comment struct {
 
 type Tag string
 
+// maxSlugLen leaves room for a collision suffix in the 200 characters of the
+// slug column.
+const maxSlugLen = 180
+
+// maxSlugSuffix is the last numeric suffix tried before a random one.
+const maxSlugSuffix = 10
+
+var nonSlugChars = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+// reservedSlugs are taken by routes under /api/articles.
+var reservedSlugs = map[string]bool{"feed": true, "drafts": true, "search": true}
+
+// slugify turns title into lowercase words joined by dashes.
 func slugify(title string) string {
-	re, _ := regexp.Compile(`[^\w]`)
-	return strings.ToLower(re.ReplaceAllString(title, "-"))
+	slug := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if r := []rune(slug); len(r) > maxSlugLen {
+		slug = strings.TrimRight(string(r[:maxSlugLen]), "-")
+	}
+	if slug == "" {
+		return "article"
+	}
+	return slug
 }
 
+// uniqueSlug returns a slug for the title of article id, 0 for a new one.
+// Slugs other articles have or had get a numeric suffix, then a random one.
+func (uc *SocialUsecase) uniqueSlug(ctx context.Context, title string, id uint) (string, error) {
+	base := slugify(title)
+	for i := 1; i <= maxSlugSuffix+1; i++ {
+		slug := base
+		switch {
+		case i > maxSlugSuffix:
+			b := make([]byte, 4)
+			if _, err := rand.Read(b); err != nil {
+				return "", err
+			}
+			slug = base + "-" + hex.EncodeToString(b)
+		case i > 1:
+			slug = fmt.Sprintf("%s-%d", base, i)
+		}
+		if reservedSlugs[slug] {
+			continue
+		}
+		owner, err := uc.ar.SlugOwner(ctx, slug)
+		if err != nil {
+			return "", err
+		}
+		if owner == 0 || owner == id {
+			return slug, nil
+		}
+	}
+	return "", errors.Conflict("article", "no free slug")
+}
+
+// checkTitle fails if an article other than id, 0 for a new one, has title.
+func (uc *SocialUsecase) checkTitle(ctx context.Context, title string, id uint) error {
+	taken, err := uc.ar.TitleTaken(ctx, title, id)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errors.New(422, "title", "has already been taken")
+	}
+	return nil
+}
+
+// verifyAuthor reports whether user id is the owner or a co-author of the
+// article, and so may edit it.
 func (o *Article) verifyAuthor(id uint) bool {
-	return o.AuthorUserID == id
+	if o.AuthorUserID == id {
+		return true
+	}
+	for _, x := range o.CoAuthorIDs {
+		if x == id {
+			return true
+		}
+	}
+	return false
 }
 
 func (o *Comment) verifyAuthor(id uint) bool {
@@ -104,102 +215,342 @@ func NewSocialUsecase(
 	ar ArticleRepo,
 	pr ProfileRepo,
 	cr CommentRepo,
+	rr RevisionRepo,
+	sr SeriesRepo,
+	car CoAuthorRepo,
+	tr TrashRepo,
+	tl TimelineRepo,
+	si SearchIndex,
+	tx Transaction,
 	logger log.Logger) *SocialUsecase {
-	return &SocialUsecase{ar: ar, cr: cr, pr: pr, log: log.NewHelper(logger)}
+	return &SocialUsecase{ar: ar, cr: cr, pr: pr, rr: rr, sr: sr, car: car, tr: tr, tl: tl, si: si, tx: tx, log: log.NewHelper(logger)}
 }
 
 func (uc *SocialUsecase) GetArticle(ctx context.Context, slug string) (rv *Article, err error) {
-	return uc.ar.Get(ctx, slug)
+	rv, err = uc.visibleArticle(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if rv.Series, err = uc.articleSeries(ctx, rv); err != nil {
+		return nil, err
+	}
+	if err := uc.fillAuthors(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
 }
 
 func (uc *SocialUsecase) CreateArticle(ctx context.Context, in *Article) (rv *Article, err error) {
 	u := auth.FromContext(ctx)
-	in.Slug = slugify(in.Title)
 	in.AuthorUserID = u.UserID
+	if err := schedule(in, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := render(in); err != nil {
+		return nil, err
+	}
+
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := uc.checkTitle(ctx, in.Title, 0); err != nil {
+			return err
+		}
+		in.Slug, err = uc.uniqueSlug(ctx, in.Title, 0)
+		if err != nil {
+			return err
+		}
+		authors, err := uc.profileLoader(ctx).Load(ctx, u.UserID)
+		if err != nil {
+			return err
+		}
+		if authors[u.UserID] == nil {
+			return ErrUserNotFound
+		}
+		rv, err = uc.ar.Create(ctx, in)
+		if err != nil {
+			return err
+		}
+		if _, err := uc.rr.Create(ctx, newRevision(rv, u.UserID)); err != nil {
+			return err
+		}
+		rv.Author = authors[u.UserID]
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.indexArticle(ctx, rv)
+	uc.pushArticle(ctx, rv)
+	if rv.Status == StatusPublished {
+		uc.recountArticles(ctx, rv.AuthorUserID)
+	}
+	return rv, nil
+}
+
+func (uc *SocialUsecase) profileLoader(ctx context.Context) *ProfileLoader {
+	return NewProfileLoader(uc.pr, auth.GetUserIdOrNotLogin(ctx))
+}
 
-	AuthorUser, err := uc.pr.GetProfileById(ctx, u.UserID)
+// recountArticles updates the articles count of an owner after one of their
+// articles was published, unpublished or deleted. Like indexArticle it runs
+// after the write has committed, and the next recount fixes a failed one.
+func (uc *SocialUsecase) recountArticles(ctx context.Context, uid uint) {
+	if err := uc.pr.RecountArticles(ctx, uid); err != nil {
+		uc.log.WithContext(ctx).Errorf("recount articles of %d: %v", uid, err)
+	}
+}
+
+// fillAuthors sets the Author and CoAuthors of every article, with one lookup
+// for all.
+func (uc *SocialUsecase) fillAuthors(ctx context.Context, as ...*Article) error {
+	ids := make([]uint, 0, len(as))
+	for _, a := range as {
+		ids = append(ids, a.AuthorUserID)
+		ids = append(ids, a.CoAuthorIDs...)
+	}
+	authors, err := uc.profileLoader(ctx).Load(ctx, ids...)
 	if err != nil {
-		return nil, ErrUserNotFound
+		return err
+	}
+	profile := func(id uint) *Profile {
+		if p := authors[id]; p != nil {
+			return p
+		}
+		return &Profile{ID: id}
+	}
+	for _, a := range as {
+		a.Author = profile(a.AuthorUserID)
+		a.CoAuthors = make([]*Profile, len(a.CoAuthorIDs))
+		for i, id := range a.CoAuthorIDs {
+			a.CoAuthors[i] = profile(id)
+		}
+	}
+	return nil
+}
+
+// fillCommentAuthors is fillAuthors for comments.
+func (uc *SocialUsecase) fillCommentAuthors(ctx context.Context, cs ...*Comment) error {
+	ids := make([]uint, len(cs))
+	for i, c := range cs {
+		ids[i] = c.AuthorID
 	}
-	in.Username = AuthorUser.Username
-	in.Email = AuthorUser.Email
-	in.Image = AuthorUser.Image
-	a, err := uc.ar.Create(ctx, in)
+	authors, err := uc.profileLoader(ctx).Load(ctx, ids...)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	for _, c := range cs {
+		c.Author = authors[c.AuthorID]
+		if c.Author == nil {
+			c.Author = &Profile{ID: c.AuthorID}
+		}
 	}
-	return a, err
+	return nil
 }
 
 func (uc *SocialUsecase) DeleteArticle(ctx context.Context, slug string) (err error) {
-	a, err := uc.ar.Get(ctx, slug)
+	var a *Article
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		a, err = uc.articleOfOwner(ctx, slug)
+		if err != nil {
+			return err
+		}
+		return uc.ar.Delete(ctx, a)
+	})
 	if err != nil {
 		return err
 	}
-	if !a.verifyAuthor(auth.FromContext(ctx).UserID) {
-		return errors.Unauthorized("user", "verifyAuthor fail")
+	uc.unindexArticle(ctx, a.ID)
+	uc.unpushArticle(ctx, a.ID)
+	if a.Status == StatusPublished {
+		uc.recountArticles(ctx, a.AuthorUserID)
 	}
-	return uc.ar.Delete(ctx, a)
+	return nil
 }
 
 func (uc *SocialUsecase) AddComment(ctx context.Context, slug string, in *Comment) (rv *Comment, err error) {
 	u := auth.FromContext(ctx)
 	in.AuthorID = u.UserID
-	in.Article = &Article{Slug: slug}
-	return uc.cr.Create(ctx, in)
-}
-
-func (uc *SocialUsecase) ListComments(ctx context.Context, slug string) (rv []*Comment, err error) {
-	return uc.cr.List(ctx, slug)
+	in.Article, err = uc.visibleArticle(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkBlocked(ctx, in.Article); err != nil {
+		return nil, err
+	}
+	in.ArticleID = in.Article.ID
+	rv, err = uc.cr.Create(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillCommentAuthors(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
 }
 
-func (uc *SocialUsecase) DeleteComment(ctx context.Context, id uint) (err error) {
-	a, err := uc.cr.Get(ctx, id)
+// ListComments returns the comments of an article, the earliest first, all
+// of them when limit is 0. It starts after cursor, the next cursor of the
+// previous page, if set.
+func (uc *SocialUsecase) ListComments(ctx context.Context, slug, cursor string, limit int64) (rv []*Comment, next string, err error) {
+	a, err := uc.visibleArticle(ctx, slug)
 	if err != nil {
-		return err
+		return nil, "", err
+	}
+	var c commentCursor
+	if cursor != "" {
+		if err := decodeCursor(cursor, &c); err != nil {
+			return nil, "", err
+		}
+		if c.ID == 0 {
+			return nil, "", ErrBadCursor
+		}
+	}
+	n := 0
+	if limit > 0 {
+		n = int(limit) + 1
+	}
+	rv, err = uc.cr.List(ctx, CommentQuery{
+		ArticleID: a.ID,
+		AfterID:   c.ID,
+		Limit:     n,
+		MutedBy:   auth.GetUserIdOrNotLogin(ctx),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if limit > 0 && int64(len(rv)) > limit {
+		rv = rv[:limit]
+		next = encodeCursor(commentCursor{ID: rv[len(rv)-1].ID})
 	}
-	if !a.verifyAuthor(auth.FromContext(ctx).UserID) {
-		return errors.Unauthorized("user", "verifyAuthor fail")
+	if err := uc.fillCommentAuthors(ctx, rv...); err != nil {
+		return nil, "", err
 	}
-	err = uc.cr.Delete(ctx, id)
-	return err
+	return rv, next, nil
+}
+
+func (uc *SocialUsecase) DeleteComment(ctx context.Context, id uint) (err error) {
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		a, err := uc.cr.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !a.verifyAuthor(auth.FromContext(ctx).UserID) {
+			return errors.Unauthorized("user", "verifyAuthor fail")
+		}
+		return uc.cr.Delete(ctx, id)
+	})
 }
 
-func (uc *SocialUsecase) FeedArticles(ctx context.Context, opts ...DbOption) (rv []*Article, count int64, err error) {
-	rv, count, err = uc.ar.List(ctx, ListOptions{}, opts...)
+// FeedArticles lists the articles of the authors the current user follows,
+// like ListArticles.
+func (uc *SocialUsecase) FeedArticles(ctx context.Context, q ArticleQuery) (rv []*Article, count int64, next string, err error) {
+	uid := auth.FromContext(ctx).UserID
+	q.FeedOf = uid
+	q.MutedBy = uid
+	rv, count, next, err = uc.listArticles(ctx, q)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	for _, a := range rv {
+		a.Favorited = uc.ar.CheckFavorited(ctx, uid, a.ID)
 	}
-	return rv, count, nil
+	if err := uc.fillAuthors(ctx, rv...); err != nil {
+		return nil, 0, "", err
+	}
+	return rv, count, next, nil
 }
 
-func (uc *SocialUsecase) ListArticles(ctx context.Context, los ListOptions, opts ...DbOption) (rv []*Article, count int64, err error) {
+// ListArticles returns a page of the articles of q, how many there are unless
+// q has a cursor, and the cursor of the next page.
+func (uc *SocialUsecase) ListArticles(ctx context.Context, q ArticleQuery) (rv []*Article, count int64, next string, err error) {
 	uid := auth.GetUserIdOrNotLogin(ctx)
-	rv, count, err = uc.ar.List(ctx, los, opts...)
+	q.MutedBy = uid
+	q.HidePrivate, q.Viewer = true, uid
+	rv, count, next, err = uc.listArticles(ctx, q)
 
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
 
 	if uid > 0 {
 		for i, article := range rv {
-			rv[i].Favorited = uc.ar.CheckFavorited(uid, article.ID)
+			rv[i].Favorited = uc.ar.CheckFavorited(ctx, uid, article.ID)
 		}
 	}
+	if err := uc.fillAuthors(ctx, rv...); err != nil {
+		return nil, 0, "", err
+	}
 
-	return rv, count, nil
+	return rv, count, next, nil
 }
 
 func (uc *SocialUsecase) UpdateArticle(ctx context.Context, in *Article) (rv *Article, err error) {
-	a, err := uc.ar.Get(ctx, in.Slug)
+	var was ArticleStatus
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		a, err := uc.visibleArticle(ctx, in.Slug)
+		if err != nil {
+			return err
+		}
+		was = a.Status
+		if !a.verifyAuthor(auth.FromContext(ctx).UserID) {
+			return errors.Unauthorized("user", "verifyAuthor fail")
+		}
+		rv, err = uc.updateArticle(ctx, a, in, 0)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.indexArticle(ctx, rv)
+	uc.pushArticle(ctx, rv)
+	if rv.Status != was {
+		uc.recountArticles(ctx, rv.AuthorUserID)
+	}
+	if err := uc.fillAuthors(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// updateArticle saves in over a and stores the result as its next revision,
+// restoredFrom being the revision in comes from, if any. It runs in the
+// transaction of the caller.
+func (uc *SocialUsecase) updateArticle(ctx context.Context, a *Article, in *Article, restoredFrom uint32) (*Article, error) {
+	// 标题变了才换 slug, 旧的 slug 由 repo 记入历史
+	in.ID, in.Slug = a.ID, a.Slug
+	if err := reschedule(a, in, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := render(in); err != nil {
+		return nil, err
+	}
+	if in.Title != "" && in.Title != a.Title {
+		if err := uc.checkTitle(ctx, in.Title, a.ID); err != nil {
+			return nil, err
+		}
+		slug, err := uc.uniqueSlug(ctx, in.Title, a.ID)
+		if err != nil {
+			return nil, err
+		}
+		in.Slug = slug
+	}
+	save := uc.ar.Update
+	if restoredFrom != 0 {
+		save = uc.ar.Restore
+	}
+	if _, err := save(ctx, in); err != nil {
+		return nil, err
+	}
+	// 重新读取, 修订记录的是更新后的完整内容
+	rv, err := uc.ar.GetArticle(ctx, a.ID)
 	if err != nil {
 		return nil, err
 	}
-	if !a.verifyAuthor(auth.FromContext(ctx).UserID) {
-		return nil, errors.Unauthorized("user", "verifyAuthor fail")
+	r := newRevision(rv, auth.FromContext(ctx).UserID)
+	r.RestoredFrom = restoredFrom
+	if _, err := uc.rr.Create(ctx, r); err != nil {
+		return nil, err
 	}
-	rv, err = uc.ar.Update(ctx, in)
-	return rv, err
+	return rv, nil
 }
 
 func (uc *SocialUsecase) GetTags(ctx context.Context) (rv []Tag, err error) {
@@ -207,34 +558,48 @@ func (uc *SocialUsecase) GetTags(ctx context.Context) (rv []Tag, err error) {
 }
 
 func (uc *SocialUsecase) FavoriteArticle(ctx context.Context, slug string) (rv *Article, err error) {
-	a, err := uc.ar.Get(ctx, slug)
-	if err != nil {
-		return nil, err
-	}
 	cu := auth.FromContext(ctx)
-	err = uc.ar.Favorite(ctx, cu.UserID, a.ID)
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		a, err := uc.visibleArticle(ctx, slug)
+		if err != nil {
+			return err
+		}
+		if err := uc.checkBlocked(ctx, a); err != nil {
+			return err
+		}
+		err = uc.ar.Favorite(ctx, cu.UserID, a.ID)
+		if err != nil {
+			return err
+		}
+		rv, err = uc.ar.GetArticle(ctx, a.ID)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	a, err = uc.ar.GetArticle(ctx, a.ID)
-	if err != nil {
+	if err := uc.fillAuthors(ctx, rv); err != nil {
 		return nil, err
 	}
-	a.Favorited = true
-	return a, nil
+	rv.Favorited = true
+	return rv, nil
 }
 
 func (uc *SocialUsecase) UnfavoriteArticle(ctx context.Context, slug string) (rv *Article, err error) {
-	a, err := uc.ar.Get(ctx, slug)
+	cu := auth.FromContext(ctx)
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		rv, err = uc.visibleArticle(ctx, slug)
+		if err != nil {
+			return err
+		}
+		return uc.ar.Unfavorite(ctx, cu.UserID, rv.ID)
+	})
 	if err != nil {
 		return nil, err
 	}
-	cu := auth.FromContext(ctx)
-	err = uc.ar.Unfavorite(ctx, cu.UserID, a.ID)
-	if err != nil {
+	if err := uc.fillAuthors(ctx, rv); err != nil {
 		return nil, err
 	}
 
-	a.Favorited = false
-	return a, nil
+	rv.Favorited = false
+	return rv, nil
 }
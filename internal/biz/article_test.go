@@ -0,0 +1,165 @@
+package biz_test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestCreateAndListArticles(t *testing.T) {
+	app := newTestApp(t)
+	alice := app.register(t, "alice")
+	app.createArticle(t, alice, "How to train your dragon", "dragons", "training")
+	app.createArticle(t, alice, "How to tame a dragon", "dragons")
+	app.createArticle(t, alice, "Unrelated")
+
+	rv, count, _, err := app.social.ListArticles(context.Background(), biz.ArticleQuery{Tags: []string{"dragons"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"how-to-tame-a-dragon", "how-to-train-your-dragon"}; count != 2 || !reflect.DeepEqual(slugs(rv), want) {
+		t.Fatalf("ListArticles = %q (%d), want %q", slugs(rv), count, want)
+	}
+	if rv[1].Author == nil || rv[1].Author.Username != "alice" {
+		t.Errorf("author = %+v, want alice", rv[1].Author)
+	}
+	if want := []string{"dragons", "training"}; !reflect.DeepEqual(rv[1].TagList, want) {
+		t.Errorf("tags = %q, want %q", rv[1].TagList, want)
+	}
+
+	a, err := app.social.GetArticle(context.Background(), "how-to-train-your-dragon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Title != "How to train your dragon" {
+		t.Errorf("GetArticle title = %q", a.Title)
+	}
+	if _, err := app.social.GetArticle(context.Background(), "nonexistent"); !errors.IsNotFound(err) {
+		t.Errorf("GetArticle of an unknown slug: %v, want not found", err)
+	}
+	if err := app.social.DeleteComment(context.Background(), 42); !errors.IsNotFound(err) {
+		t.Errorf("DeleteComment of an unknown id: %v, want not found", err)
+	}
+}
+
+func TestFavoriteArticle(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	a := app.createArticle(t, alice, "Dragons")
+	slug := a.Slug
+
+	rv, err := app.social.FavoriteArticle(bob, slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rv.Favorited || rv.FavoritesCount != 1 {
+		t.Errorf("after favorite: favorited %v, count %d", rv.Favorited, rv.FavoritesCount)
+	}
+	list, _, _, err := app.social.ListArticles(bob, biz.ArticleQuery{FavoritedBy: "bob"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || !list[0].Favorited {
+		t.Errorf("favorited by bob = %q", slugs(list))
+	}
+	if _, err := app.social.UnfavoriteArticle(bob, slug); err != nil {
+		t.Fatal(err)
+	}
+	if rv, err = app.social.GetArticle(bob, slug); err != nil {
+		t.Fatal(err)
+	}
+	if rv.FavoritesCount != 0 {
+		t.Errorf("after unfavorite: count %d", rv.FavoritesCount)
+	}
+}
+
+func TestArticleSlugs(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	for _, c := range []struct{ title, slug string }{
+		{"Hello, World!", "hello-world"},
+		{"hello world", "hello-world-2"},
+		{"Hello -- World", "hello-world-3"},
+		{"  ¿Qué tal?  ", "qué-tal"},
+		{"!!!", "article"},
+	} {
+		if a := app.createArticle(t, alice, c.title); a.Slug != c.slug {
+			t.Errorf("slug of %q = %q, want %q", c.title, a.Slug, c.slug)
+		}
+	}
+	// 标题唯一, slug 相同
+	for _, title := range []string{"Same", "same", "SAME", "Same!", "Same?", "Same.", "same!", "same?", "same.", "SAME!"} {
+		app.createArticle(t, bob, title)
+	}
+	if a := app.createArticle(t, bob, "SAME?"); !strings.HasPrefix(a.Slug, "same-") || len(a.Slug) != len("same-")+8 {
+		t.Errorf("slug after the numeric suffixes = %q, want a random suffix", a.Slug)
+	}
+
+	// 改名后旧 slug 仍能找到文章, 且不会给别的文章
+	rv, err := app.social.UpdateArticle(alice, &biz.Article{Slug: "hello-world", Title: "Goodbye"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rv.Slug != "goodbye" {
+		t.Errorf("slug after rename = %q, want goodbye", rv.Slug)
+	}
+	for _, slug := range []string{"hello-world", "goodbye"} {
+		a, err := app.social.GetArticle(context.Background(), slug)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Slug != "goodbye" {
+			t.Errorf("GetArticle(%s) = %s, want goodbye", slug, a.Slug)
+		}
+	}
+	if a := app.createArticle(t, bob, "Hello world"); a.Slug != "hello-world-4" {
+		t.Errorf("slug reusing an old one = %q, want hello-world-4", a.Slug)
+	}
+	if rv, err = app.social.UpdateArticle(alice, &biz.Article{Slug: "goodbye", Title: "Goodbye", Body: "new"}); err != nil {
+		t.Fatal(err)
+	}
+	if rv.Slug != "goodbye" {
+		t.Errorf("slug after an update keeping the title = %q", rv.Slug)
+	}
+}
+
+func TestFeedArticles(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob, carol := app.register(t, "alice"), app.register(t, "bob"), app.register(t, "carol")
+	app.createArticle(t, alice, "By alice")
+	app.createArticle(t, carol, "By carol")
+
+	if _, err := app.profiles.FollowUser(bob, uid(bob), "alice"); err != nil {
+		t.Fatal(err)
+	}
+	rv, _, _, err := app.social.FeedArticles(bob, biz.ArticleQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"by-alice"}; !reflect.DeepEqual(slugs(rv), want) {
+		t.Errorf("feed = %q, want %q", slugs(rv), want)
+	}
+	if _, err := app.social.FavoriteArticle(bob, "by-alice"); err != nil {
+		t.Fatal(err)
+	}
+	app.createArticle(t, alice, "Again by alice")
+	if rv, _, _, err = app.social.FeedArticles(bob, biz.ArticleQuery{}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"again-by-alice", "by-alice"}; !reflect.DeepEqual(slugs(rv), want) {
+		t.Errorf("feed = %q, want %q", slugs(rv), want)
+	} else if rv[0].Favorited || !rv[1].Favorited {
+		t.Errorf("favorited = %v, %v, want false, true", rv[0].Favorited, rv[1].Favorited)
+	}
+	if _, err := app.profiles.UnFollowUser(bob, uid(bob), "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if rv, _, _, err = app.social.FeedArticles(bob, biz.ArticleQuery{}); err != nil || len(rv) != 0 {
+		t.Errorf("feed after unfollow = %q, %v", slugs(rv), err)
+	}
+}
@@ -1,6 +1,16 @@
 package biz
 
-import "github.com/google/wire"
+import (
+	"context"
+
+	"github.com/google/wire"
+)
 
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(NewUserUsecase, NewProfileUsecase, NewArticleUsecase, NewSocialUsecase)
+
+// Transaction runs fn so that every repo call made with the ctx it is given
+// commits or rolls back together.
+type Transaction interface {
+	ExecTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
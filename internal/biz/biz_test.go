@@ -0,0 +1,85 @@
+package biz_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+	"realworld/internal/data"
+	"realworld/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// testApp holds the usecases wired on the memory driver, like wireApp does on
+// a database.
+type testApp struct {
+	users    *biz.UserUsecase
+	profiles *biz.ProfileUsecase
+	social   *biz.SocialUsecase
+}
+
+func newTestApp(t *testing.T) *testApp {
+	t.Helper()
+	return newTestAppWith(t, &conf.Data{})
+}
+
+// newTestAppWith is newTestApp with the data settings c.
+func newTestAppWith(t *testing.T, c *conf.Data) *testApp {
+	t.Helper()
+	logger := log.NewStdLogger(io.Discard)
+	d := data.NewMemoryData(logger)
+	tx := data.NewTransaction(d)
+	pr := data.NewProfileRepo(d, logger)
+	tl := data.NewTimelineRepo(c, d, logger)
+	si, cleanup, err := data.NewSearchIndex(c, d, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return &testApp{
+		users:    biz.NewUserUsecase(data.NewUserRepo(d, logger), tx, logger, &conf.JWT{Secret: "secret"}),
+		profiles: biz.NewProfileUsecase(pr, tl, logger),
+		social: biz.NewSocialUsecase(data.NewArticleRepo(d, logger), pr, data.NewCommentRepo(d, logger),
+			data.NewRevisionRepo(d, logger), data.NewSeriesRepo(d, logger), data.NewCoAuthorRepo(d, logger),
+			data.NewTrashRepo(d, logger), tl, si, tx, logger),
+	}
+}
+
+// register signs name up and returns a context of requests made by them.
+func (a *testApp) register(t *testing.T, name string) context.Context {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := a.users.Registration(ctx, name+"@example.com", "password", name); err != nil {
+		t.Fatal(err)
+	}
+	p, err := a.profiles.GetProfile(ctx, 0, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return auth.WithContext(ctx, &auth.CurrentUser{UserID: p.ID})
+}
+
+func (a *testApp) createArticle(t *testing.T, ctx context.Context, title string, tags ...string) *biz.Article {
+	t.Helper()
+	rv, err := a.social.CreateArticle(ctx, &biz.Article{Title: title, Description: "description", Body: "body", TagList: tags})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rv
+}
+
+func slugs(articles []*biz.Article) []string {
+	rv := make([]string, 0, len(articles))
+	for _, a := range articles {
+		rv = append(rv, a.Slug)
+	}
+	return rv
+}
+
+// uid is the user making the requests of ctx.
+func uid(ctx context.Context) uint {
+	return auth.FromContext(ctx).UserID
+}
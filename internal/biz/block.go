@@ -0,0 +1,92 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/pkg/middleware/auth"
+)
+
+var (
+	// ErrProfileNotFound is returned for users that don't exist, and to the
+	// users they block.
+	ErrProfileNotFound = errors.NotFound("user", "not found by username")
+	// ErrBlocked is returned to users acting on articles of someone who
+	// blocks them.
+	ErrBlocked = errors.Forbidden("user", "blocked by the author")
+	// ErrSelf is returned for blocking or muting oneself.
+	ErrSelf = errors.New(422, "username", "can't be yourself")
+)
+
+// visibleProfile returns the profile of username as seen by uid, unless that
+// user blocks uid.
+func (s *ProfileUsecase) visibleProfile(ctx context.Context, uid uint, username string) (*Profile, error) {
+	rv, err := s.repo.GetProfile(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	if uid > 0 {
+		blocked, err := s.repo.BlockedBy(ctx, uid, rv.ID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, ErrProfileNotFound
+		}
+	}
+	return rv, nil
+}
+
+// BlockUser blocks username for uid and ends the follows between them.
+func (s *ProfileUsecase) BlockUser(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
+	rv, err = s.repo.GetProfile(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	if rv.ID == uid {
+		return nil, ErrSelf
+	}
+	rv, err = s.repo.BlockUser(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tl.RemoveAuthor(ctx, uid, rv.ID); err != nil {
+		s.log.WithContext(ctx).Errorf("remove %d from timeline of %d: %v", rv.ID, uid, err)
+	}
+	if err := s.tl.RemoveAuthor(ctx, rv.ID, uid); err != nil {
+		s.log.WithContext(ctx).Errorf("remove %d from timeline of %d: %v", uid, rv.ID, err)
+	}
+	return rv, nil
+}
+
+func (s *ProfileUsecase) UnblockUser(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
+	return s.repo.UnblockUser(ctx, uid, username)
+}
+
+// MuteUser hides the articles and comments of username from uid.
+func (s *ProfileUsecase) MuteUser(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
+	rv, err = s.repo.GetProfile(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	if rv.ID == uid {
+		return nil, ErrSelf
+	}
+	return s.repo.MuteUser(ctx, uid, username)
+}
+
+func (s *ProfileUsecase) UnmuteUser(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
+	return s.repo.UnmuteUser(ctx, uid, username)
+}
+
+// checkBlocked returns ErrBlocked if an author of a blocks the current user.
+func (uc *SocialUsecase) checkBlocked(ctx context.Context, a *Article) error {
+	blocked, err := uc.pr.BlockedBy(ctx, auth.FromContext(ctx).UserID, append([]uint{a.AuthorUserID}, a.CoAuthorIDs...)...)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrBlocked
+	}
+	return nil
+}
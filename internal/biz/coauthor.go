@@ -0,0 +1,203 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/pkg/middleware/auth"
+)
+
+// ErrInvitationNotFound is returned when accepting an invitation that was
+// never sent, or was withdrawn.
+var ErrInvitationNotFound = errors.NotFound("invitation", "invitation not found")
+
+// CoAuthor is a user who was invited to co-author an article, and who is one
+// once AcceptedAt is set. The owner of the article is its AuthorUserID.
+type CoAuthor struct {
+	ArticleID  uint
+	UserID     uint
+	InvitedBy  uint
+	CreatedAt  time.Time
+	AcceptedAt time.Time
+
+	Article *Article
+	User    *Profile
+	Inviter *Profile
+}
+
+type CoAuthorRepo interface {
+	// Invite records a pending invitation, it fails if the user already is
+	// invited or a co-author.
+	Invite(ctx context.Context, c *CoAuthor) error
+	// Get returns the invitation or co-authorship of a user on an article, or
+	// ErrInvitationNotFound.
+	Get(ctx context.Context, articleID, userID uint) (*CoAuthor, error)
+	Accept(ctx context.Context, articleID, userID uint) error
+	// Remove removes a co-author or withdraws an invitation.
+	Remove(ctx context.Context, articleID, userID uint) error
+	// ListInvitations returns the pending invitations of a user, the latest
+	// first.
+	ListInvitations(ctx context.Context, userID uint) ([]*CoAuthor, error)
+}
+
+// verifyOwner reports whether user id owns the article, and so may delete it
+// and choose its co-authors.
+func (o *Article) verifyOwner(id uint) bool {
+	return o.AuthorUserID == id
+}
+
+// articleOfOwner returns the article with slug if the current user owns it.
+func (uc *SocialUsecase) articleOfOwner(ctx context.Context, slug string) (*Article, error) {
+	a, err := uc.visibleArticle(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !a.verifyOwner(auth.FromContext(ctx).UserID) {
+		return nil, errors.Unauthorized("user", "verifyOwner fail")
+	}
+	return a, nil
+}
+
+// InviteCoAuthor invites the user with username to co-author the article,
+// which only its owner may do.
+func (uc *SocialUsecase) InviteCoAuthor(ctx context.Context, slug, username string) (*CoAuthor, error) {
+	a, err := uc.articleOfOwner(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	p, err := uc.pr.GetProfile(ctx, 0, username)
+	if err != nil {
+		return nil, err
+	}
+	if a.verifyAuthor(p.ID) {
+		return nil, errors.New(422, "username", "is already an author")
+	}
+	c := &CoAuthor{ArticleID: a.ID, UserID: p.ID, InvitedBy: a.AuthorUserID}
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.car.Get(ctx, a.ID, p.ID); !errors.Is(err, ErrInvitationNotFound) {
+			if err != nil {
+				return err
+			}
+			return errors.New(422, "username", "is already invited")
+		}
+		return uc.car.Invite(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c, err = uc.car.Get(ctx, a.ID, p.ID); err != nil {
+		return nil, err
+	}
+	if err := uc.fillInvitations(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// AcceptCoAuthor makes the current user a co-author of the article they were
+// invited to.
+func (uc *SocialUsecase) AcceptCoAuthor(ctx context.Context, slug string) (rv *Article, err error) {
+	uid := auth.FromContext(ctx).UserID
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		// 被邀请者可能还看不到草稿, 不能用 visibleArticle
+		a, err := uc.ar.Get(ctx, slug)
+		if err != nil {
+			return err
+		}
+		c, err := uc.car.Get(ctx, a.ID, uid)
+		if err != nil {
+			return err
+		}
+		if c.AcceptedAt.IsZero() {
+			if err := uc.car.Accept(ctx, a.ID, uid); err != nil {
+				return err
+			}
+		}
+		rv, err = uc.ar.GetArticle(ctx, a.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.indexArticle(ctx, rv)
+	if err := uc.fillAuthors(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// RemoveCoAuthor removes the user with username from the co-authors of the
+// article, or withdraws their invitation. The owner may remove anyone, other
+// users only themselves.
+func (uc *SocialUsecase) RemoveCoAuthor(ctx context.Context, slug, username string) error {
+	uid := auth.FromContext(ctx).UserID
+	var id uint
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		a, err := uc.ar.Get(ctx, slug)
+		if err != nil {
+			return err
+		}
+		p, err := uc.pr.GetProfile(ctx, 0, username)
+		if err != nil {
+			return err
+		}
+		if !a.verifyOwner(uid) && p.ID != uid {
+			return errors.Unauthorized("user", "verifyOwner fail")
+		}
+		if _, err := uc.car.Get(ctx, a.ID, p.ID); err != nil {
+			return err
+		}
+		id = a.ID
+		return uc.car.Remove(ctx, a.ID, p.ID)
+	})
+	if err != nil {
+		return err
+	}
+	if a, err := uc.ar.GetArticle(ctx, id); err == nil {
+		uc.indexArticle(ctx, a)
+	}
+	return nil
+}
+
+// ListInvitations returns the pending invitations of the current user.
+func (uc *SocialUsecase) ListInvitations(ctx context.Context) ([]*CoAuthor, error) {
+	rv, err := uc.car.ListInvitations(ctx, auth.FromContext(ctx).UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillInvitations(ctx, rv...); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// fillInvitations sets the Article, User and Inviter of invitations.
+func (uc *SocialUsecase) fillInvitations(ctx context.Context, cs ...*CoAuthor) (err error) {
+	articles := make([]*Article, 0, len(cs))
+	ids := make([]uint, 0, 2*len(cs))
+	for _, c := range cs {
+		if c.Article, err = uc.ar.GetArticle(ctx, c.ArticleID); err != nil {
+			return err
+		}
+		articles = append(articles, c.Article)
+		ids = append(ids, c.UserID, c.InvitedBy)
+	}
+	if err := uc.fillAuthors(ctx, articles...); err != nil {
+		return err
+	}
+	profiles, err := uc.profileLoader(ctx).Load(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	profile := func(id uint) *Profile {
+		if p := profiles[id]; p != nil {
+			return p
+		}
+		return &Profile{ID: id}
+	}
+	for _, c := range cs {
+		c.User, c.Inviter = profile(c.UserID), profile(c.InvitedBy)
+	}
+	return nil
+}
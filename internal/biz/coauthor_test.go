@@ -0,0 +1,117 @@
+package biz_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestCoAuthors(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob, carol := app.register(t, "alice"), app.register(t, "bob"), app.register(t, "carol")
+	a := app.createArticle(t, alice, "Dragons")
+
+	// 邀请前不能编辑, 接受后可以
+	if _, err := app.social.UpdateArticle(bob, &biz.Article{Slug: a.Slug, Body: "by bob"}); !errors.IsUnauthorized(err) {
+		t.Errorf("update before the invitation: %v, want unauthorized", err)
+	}
+	if _, err := app.social.InviteCoAuthor(bob, a.Slug, "carol"); !errors.IsUnauthorized(err) {
+		t.Errorf("invite by a non owner: %v, want unauthorized", err)
+	}
+	c, err := app.social.InviteCoAuthor(alice, a.Slug, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.User.Username != "bob" || c.Inviter.Username != "alice" || !c.AcceptedAt.IsZero() {
+		t.Errorf("invitation = %+v", c)
+	}
+	for _, name := range []string{"bob", "alice"} {
+		if _, err := app.social.InviteCoAuthor(alice, a.Slug, name); errors.Code(err) != 422 {
+			t.Errorf("invite %s again: %v, want 422", name, err)
+		}
+	}
+	invitations, err := app.social.ListInvitations(bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invitations) != 1 || invitations[0].Article.Slug != a.Slug {
+		t.Errorf("invitations of bob = %+v", invitations)
+	}
+	if _, err := app.social.UpdateArticle(bob, &biz.Article{Slug: a.Slug, Body: "by bob"}); !errors.IsUnauthorized(err) {
+		t.Errorf("update by an invited user: %v, want unauthorized", err)
+	}
+	if _, err := app.social.AcceptCoAuthor(carol, a.Slug); !errors.IsNotFound(err) {
+		t.Errorf("accept without an invitation: %v, want not found", err)
+	}
+	rv, err := app.social.AcceptCoAuthor(bob, a.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rv.CoAuthors) != 1 || rv.CoAuthors[0].Username != "bob" {
+		t.Errorf("co-authors = %+v", rv.CoAuthors)
+	}
+	if invitations, err = app.social.ListInvitations(bob); err != nil || len(invitations) != 0 {
+		t.Errorf("invitations after accepting = %+v, %v", invitations, err)
+	}
+
+	if rv, err = app.social.UpdateArticle(bob, &biz.Article{Slug: a.Slug, Body: "by bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if rv.Body != "by bob" || rv.Author.Username != "alice" {
+		t.Errorf("updated by bob: body %q, owner %s", rv.Body, rv.Author.Username)
+	}
+	if err := app.social.DeleteArticle(bob, a.Slug); !errors.IsUnauthorized(err) {
+		t.Errorf("delete by a co-author: %v, want unauthorized", err)
+	}
+	if _, err := app.social.InviteCoAuthor(bob, a.Slug, "carol"); !errors.IsUnauthorized(err) {
+		t.Errorf("invite by a co-author: %v, want unauthorized", err)
+	}
+
+	// ?author= 也匹配合著者
+	for _, author := range []string{"alice", "bob"} {
+		list, count, _, err := app.social.ListArticles(context.Background(), biz.ArticleQuery{Author: author})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 || !reflect.DeepEqual(slugs(list), []string{a.Slug}) {
+			t.Errorf("articles of %s = %q (%d)", author, slugs(list), count)
+		}
+	}
+	if list, _, _, err := app.social.ListArticles(context.Background(), biz.ArticleQuery{Author: "carol"}); err != nil || len(list) != 0 {
+		t.Errorf("articles of carol = %q, %v", slugs(list), err)
+	}
+
+	if err := app.social.RemoveCoAuthor(carol, a.Slug, "bob"); !errors.IsUnauthorized(err) {
+		t.Errorf("remove by another user: %v, want unauthorized", err)
+	}
+	if err := app.social.RemoveCoAuthor(alice, a.Slug, "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := app.social.UpdateArticle(bob, &biz.Article{Slug: a.Slug, Body: "again"}); !errors.IsUnauthorized(err) {
+		t.Errorf("update after removal: %v, want unauthorized", err)
+	}
+	if list, _, _, err := app.social.ListArticles(context.Background(), biz.ArticleQuery{Author: "bob"}); err != nil || len(list) != 0 {
+		t.Errorf("articles of bob after removal = %q, %v", slugs(list), err)
+	}
+
+	// 合著者可以自己退出
+	if _, err := app.social.InviteCoAuthor(alice, a.Slug, "carol"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := app.social.AcceptCoAuthor(carol, a.Slug); err != nil {
+		t.Fatal(err)
+	}
+	if err := app.social.RemoveCoAuthor(carol, a.Slug, "carol"); err != nil {
+		t.Fatal(err)
+	}
+	if err := app.social.RemoveCoAuthor(alice, a.Slug, "carol"); !errors.IsNotFound(err) {
+		t.Errorf("remove twice: %v, want not found", err)
+	}
+	if err := app.social.DeleteArticle(alice, a.Slug); err != nil {
+		t.Errorf("delete by the owner: %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrBadCursor is returned for cursors that were not made by a list of the
+// same kind and order.
+var ErrBadCursor = errors.New(422, "cursor", "is invalid for this list")
+
+// articleCursor is where a list of articles stopped: after article ID, in
+// the order of the list. It keeps the sort key the article had, Updated or
+// Key, so that the list goes on from there even if the article changed.
+type articleCursor struct {
+	Sort      ArticleSort `json:"s,omitempty"`
+	Ascending bool        `json:"a,omitempty"`
+	SeriesID  uint        `json:"r,omitempty"`
+	Updated   *time.Time  `json:"t,omitempty"`
+	Key       int64       `json:"k,omitempty"`
+	ID        uint        `json:"id"`
+}
+
+// commentCursor is where a list of comments stopped.
+type commentCursor struct {
+	ID uint `json:"c"`
+}
+
+// 游标对客户端不透明, 只是 base64 编码的 JSON
+func encodeCursor(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ErrBadCursor
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return ErrBadCursor
+	}
+	return nil
+}
+
+// listArticles lists the page of q, starting after its Cursor if any, and
+// returns the cursor of the next page, empty on the last one. Pages with a
+// cursor are not counted.
+func (uc *SocialUsecase) listArticles(ctx context.Context, q ArticleQuery) (rv []*Article, count int64, next string, err error) {
+	if q.Limit <= 0 {
+		q.Limit = DefaultLimit
+	}
+	at := articleCursor{Sort: q.Sort, Ascending: q.Ascending, SeriesID: q.SeriesID}
+	if q.Cursor != "" {
+		if q.Offset > 0 {
+			return nil, 0, "", errors.New(422, "offset", "can't be used with a cursor")
+		}
+		var c articleCursor
+		if err := decodeCursor(q.Cursor, &c); err != nil {
+			return nil, 0, "", err
+		}
+		if c.ID == 0 || c.Sort != at.Sort || c.Ascending != at.Ascending || c.SeriesID != at.SeriesID ||
+			(c.Updated != nil) != (q.SeriesID == 0 && q.Sort == SortUpdated) {
+			return nil, 0, "", ErrBadCursor
+		}
+		q.AfterID, q.AfterKey = c.ID, c.Key
+		if c.Updated != nil {
+			q.AfterUpdated = *c.Updated
+		}
+	}
+	// 多取一篇, 就知道还有没有下一页
+	limit := q.Limit
+	q.Limit++
+	rv, count, err = uc.ar.List(ctx, q)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if int64(len(rv)) > limit {
+		rv = rv[:limit]
+		last := rv[len(rv)-1]
+		at.ID = last.ID
+		switch {
+		case q.KeyedSort():
+			at.Key = last.SortKey
+		case q.Sort == SortUpdated:
+			at.Updated = &last.UpdatedAt
+		}
+		next = encodeCursor(at)
+	}
+	return rv, count, next, nil
+}
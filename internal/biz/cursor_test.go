@@ -0,0 +1,92 @@
+package biz_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/internal/biz"
+)
+
+func TestArticleCursor(t *testing.T) {
+	app := newTestApp(t)
+	alice := app.register(t, "alice")
+	for i := 1; i <= 5; i++ {
+		app.createArticle(t, alice, fmt.Sprintf("a%d", i))
+	}
+
+	var got []string
+	q := biz.ArticleQuery{Limit: 2}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("too many pages")
+		}
+		rv, count, next, err := app.social.ListArticles(alice, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 带游标的页不计数
+		if want := map[bool]int64{true: 5, false: 0}[q.Cursor == ""]; count != want {
+			t.Errorf("page %d counts %d, want %d", pages, count, want)
+		}
+		got = append(got, slugs(rv)...)
+		if next == "" {
+			break
+		}
+		q.Cursor = next
+	}
+	if want := []string{"a5", "a4", "a3", "a2", "a1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("pages = %q, want %q", got, want)
+	}
+
+	_, _, next, err := app.social.ListArticles(alice, biz.ArticleQuery{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, q := range map[string]biz.ArticleQuery{
+		"garbage":     {Cursor: "!!"},
+		"other sort":  {Cursor: next, Sort: biz.SortFavorites},
+		"other order": {Cursor: next, Ascending: true},
+		"with offset": {Cursor: next, Offset: 2},
+	} {
+		if _, _, _, err := app.social.ListArticles(alice, q); errors.Code(err) != 422 {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+	if rv, _, _, err := app.social.ListArticles(alice, biz.ArticleQuery{Author: "alice", Cursor: next}); err != nil || !reflect.DeepEqual(slugs(rv), []string{"a3", "a2", "a1"}) {
+		t.Errorf("alice's articles after the cursor = %q, %v", slugs(rv), err)
+	}
+}
+
+func TestCommentCursor(t *testing.T) {
+	app := newTestApp(t)
+	alice := app.register(t, "alice")
+	a := app.createArticle(t, alice, "Hello")
+	for i := 1; i <= 3; i++ {
+		if _, err := app.social.AddComment(alice, a.Slug, &biz.Comment{Body: fmt.Sprintf("c%d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bodies := func(cs []*biz.Comment) (rv []string) {
+		for _, c := range cs {
+			rv = append(rv, c.Body)
+		}
+		return rv
+	}
+
+	rv, next, err := app.social.ListComments(alice, a.Slug, "", 2)
+	if err != nil || next == "" || !reflect.DeepEqual(bodies(rv), []string{"c1", "c2"}) {
+		t.Fatalf("first page = %q, %q, %v", bodies(rv), next, err)
+	}
+	rv, next, err = app.social.ListComments(alice, a.Slug, next, 2)
+	if err != nil || next != "" || !reflect.DeepEqual(bodies(rv), []string{"c3"}) {
+		t.Errorf("last page = %q, %q, %v", bodies(rv), next, err)
+	}
+	if rv, next, err = app.social.ListComments(alice, a.Slug, "", 0); err != nil || next != "" || len(rv) != 3 {
+		t.Errorf("all = %q, %q, %v", bodies(rv), next, err)
+	}
+	if _, _, err := app.social.ListComments(alice, a.Slug, "e30", 2); errors.Code(err) != 422 {
+		t.Errorf("cursor without an id: %v", err)
+	}
+}
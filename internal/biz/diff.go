@@ -0,0 +1,108 @@
+package biz
+
+import "strings"
+
+// DiffOp says what a line of a diff does.
+type DiffOp int
+
+const (
+	// DiffEqual lines are in both texts.
+	DiffEqual DiffOp = iota
+	// DiffInsert lines are only in the new text.
+	DiffInsert
+	// DiffDelete lines are only in the old text.
+	DiffDelete
+)
+
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// maxDiffCells bounds the table of the longest common subsequence. Beyond
+// it, the changed lines are reported as all deleted and then all inserted.
+const maxDiffCells = 1 << 20
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines returns the line diff from a to b. The lines they have in common
+// are the longest common subsequence of the part that changed.
+func diffLines(a, b string) []DiffLine {
+	x, y := splitLines(a), splitLines(b)
+	rv := make([]DiffLine, 0, len(x)+len(y))
+
+	// 先去掉相同的开头和结尾, 只对中间变化的部分求 LCS
+	head := 0
+	for head < len(x) && head < len(y) && x[head] == y[head] {
+		rv = append(rv, DiffLine{Op: DiffEqual, Text: x[head]})
+		head++
+	}
+	tail := 0
+	for tail < len(x)-head && tail < len(y)-head && x[len(x)-1-tail] == y[len(y)-1-tail] {
+		tail++
+	}
+	mx, my := x[head:len(x)-tail], y[head:len(y)-tail]
+
+	if len(mx)*len(my) > maxDiffCells {
+		for _, l := range mx {
+			rv = append(rv, DiffLine{Op: DiffDelete, Text: l})
+		}
+		for _, l := range my {
+			rv = append(rv, DiffLine{Op: DiffInsert, Text: l})
+		}
+	} else {
+		rv = appendLCSDiff(rv, mx, my)
+	}
+
+	for _, l := range x[len(x)-tail:] {
+		rv = append(rv, DiffLine{Op: DiffEqual, Text: l})
+	}
+	return rv
+}
+
+// appendLCSDiff appends the diff from x to y to rv. lcs[i][j] is the length
+// of the longest common subsequence of x[i:] and y[j:].
+func appendLCSDiff(rv []DiffLine, x, y []string) []DiffLine {
+	n, m := len(x), len(y)
+	lcs := make([]int32, (n+1)*(m+1))
+	at := func(i, j int) int32 { return lcs[i*(m+1)+j] }
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case x[i] == y[j]:
+				lcs[i*(m+1)+j] = at(i+1, j+1) + 1
+			case at(i+1, j) >= at(i, j+1):
+				lcs[i*(m+1)+j] = at(i+1, j)
+			default:
+				lcs[i*(m+1)+j] = at(i, j+1)
+			}
+		}
+	}
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case x[i] == y[j]:
+			rv = append(rv, DiffLine{Op: DiffEqual, Text: x[i]})
+			i++
+			j++
+		case at(i+1, j) >= at(i, j+1):
+			rv = append(rv, DiffLine{Op: DiffDelete, Text: x[i]})
+			i++
+		default:
+			rv = append(rv, DiffLine{Op: DiffInsert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		rv = append(rv, DiffLine{Op: DiffDelete, Text: x[i]})
+	}
+	for ; j < m; j++ {
+		rv = append(rv, DiffLine{Op: DiffInsert, Text: y[j]})
+	}
+	return rv
+}
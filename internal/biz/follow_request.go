@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/pkg/middleware/auth"
+)
+
+// ErrFollowRequestNotFound is returned for approving or denying a request
+// that wasn't made.
+var ErrFollowRequestNotFound = errors.NotFound("follow request", "not found")
+
+// ListFollowRequests returns a page of the users asking to follow uid, the
+// latest first, and how many there are.
+func (s *ProfileUsecase) ListFollowRequests(ctx context.Context, uid uint, limit, offset int64) ([]*Profile, int64, error) {
+	var count int64
+	rv, err := s.listProfiles(ctx, uid, limit, offset, uid, func(ctx context.Context, uid uint, limit, offset int64) (ids []uint, err error) {
+		ids, count, err = s.repo.FollowRequests(ctx, uid, limit, offset)
+		return ids, err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return rv, count, nil
+}
+
+// ApproveFollowRequest lets username follow uid and fills the timeline of
+// username like FollowUser does.
+func (s *ProfileUsecase) ApproveFollowRequest(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
+	rv, err = s.repo.ApproveFollowRequest(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tl.Backfill(ctx, rv.ID, uid); err != nil {
+		s.log.WithContext(ctx).Errorf("backfill timeline of %d with %d: %v", rv.ID, uid, err)
+	}
+	return rv, nil
+}
+
+func (s *ProfileUsecase) DenyFollowRequest(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
+	return s.repo.DenyFollowRequest(ctx, uid, username)
+}
+
+// seesArticle reports whether the current user may see the published article
+// a, which they can't if its owner is private and they neither write it nor
+// follow the owner.
+func (uc *SocialUsecase) seesArticle(ctx context.Context, a *Article) (bool, error) {
+	uid := auth.GetUserIdOrNotLogin(ctx)
+	if uid > 0 && a.verifyAuthor(uid) {
+		return true, nil
+	}
+	return uc.pr.SeesArticles(ctx, uid, a.AuthorUserID)
+}
@@ -9,17 +9,49 @@ type ProfileRepo interface {
 	GetProfile(ctx context.Context, uid uint, username string) (*Profile, error)
 	GetProfileById(ctx context.Context, uid uint) (rv *Profile, err error)
 	FollowUser(ctx context.Context, uid uint, username string) (*Profile, error)
+	// UnFollowUser also withdraws a pending follow request.
 	UnFollowUser(ctx context.Context, uid uint, username string) (*Profile, error)
+	// ListProfiles returns the profiles of the users ids that exist, with
+	// Following set for viewer.
+	ListProfiles(ctx context.Context, viewer uint, ids []uint) ([]*Profile, error)
+	// Followers returns the ids of the users following uid, the latest first.
+	Followers(ctx context.Context, uid uint, limit, offset int64) ([]uint, error)
+	// Followed returns the ids of the users uid follows, the latest first.
+	Followed(ctx context.Context, uid uint, limit, offset int64) ([]uint, error)
+	// RecountArticles updates the ArticlesCount of uid.
+	RecountArticles(ctx context.Context, uid uint) error
+	// BlockUser blocks username for uid and ends the follows between them.
+	BlockUser(ctx context.Context, uid uint, username string) (*Profile, error)
+	UnblockUser(ctx context.Context, uid uint, username string) (*Profile, error)
+	MuteUser(ctx context.Context, uid uint, username string) (*Profile, error)
+	UnmuteUser(ctx context.Context, uid uint, username string) (*Profile, error)
+	// BlockedBy reports whether any of the users ids blocks uid.
+	BlockedBy(ctx context.Context, uid uint, ids ...uint) (bool, error)
+	// RequestFollow asks username, a private user, to let uid follow them.
+	RequestFollow(ctx context.Context, uid uint, username string) (*Profile, error)
+	// FollowRequests returns the ids of the users asking to follow uid, the
+	// latest first, and how many there are.
+	FollowRequests(ctx context.Context, uid uint, limit, offset int64) ([]uint, int64, error)
+	// ApproveFollowRequest turns the request of username into a follow of
+	// uid, and DenyFollowRequest drops it. Both return ErrFollowRequestNotFound
+	// without a request.
+	ApproveFollowRequest(ctx context.Context, uid uint, username string) (*Profile, error)
+	DenyFollowRequest(ctx context.Context, uid uint, username string) (*Profile, error)
+	// SeesArticles reports whether uid may see the articles of id: id is
+	// public, is uid, or uid follows id.
+	SeesArticles(ctx context.Context, uid, id uint) (bool, error)
 }
 
 type ProfileUsecase struct {
 	repo ProfileRepo
+	tl   TimelineRepo
 	log  *log.Helper
 }
 
-func NewProfileUsecase(repo ProfileRepo, logger log.Logger) *ProfileUsecase {
+func NewProfileUsecase(repo ProfileRepo, tl TimelineRepo, logger log.Logger) *ProfileUsecase {
 	return &ProfileUsecase{
 		repo: repo,
+		tl:   tl,
 		log:  log.NewHelper(logger),
 	}
 }
@@ -31,16 +63,138 @@ type Profile struct {
 	Image     string `json:"image"`
 	Email     string `json:"email"`
 	Following bool   `json:"following"`
+	Blocking  bool   `json:"blocking"`
+	Muting    bool   `json:"muting"`
+	// Private users approve their followers, and Requested is set while the
+	// viewer waits for that.
+	Private        bool   `json:"private"`
+	Requested      bool   `json:"requested"`
+	FollowersCount uint32 `json:"followersCount"`
+	FollowingCount uint32 `json:"followingCount"`
+	// ArticlesCount counts the published articles the user owns.
+	ArticlesCount uint32 `json:"articlesCount"`
+}
+
+// ProfileLoader resolves the profiles a request shows in batches, as seen by
+// the user making it, and asks for each of them at most once.
+type ProfileLoader struct {
+	repo     ProfileRepo
+	viewer   uint
+	profiles map[uint]*Profile
+}
+
+func NewProfileLoader(repo ProfileRepo, viewer uint) *ProfileLoader {
+	return &ProfileLoader{repo: repo, viewer: viewer, profiles: make(map[uint]*Profile)}
+}
+
+// Load returns the profiles of ids by user id, fetching the ones it hasn't
+// seen yet in a single call. Users that don't exist are left out.
+func (l *ProfileLoader) Load(ctx context.Context, ids ...uint) (map[uint]*Profile, error) {
+	var missing []uint
+	for _, id := range ids {
+		if _, ok := l.profiles[id]; !ok {
+			missing = append(missing, id)
+			l.profiles[id] = nil
+		}
+	}
+	if len(missing) > 0 {
+		ps, err := l.repo.ListProfiles(ctx, l.viewer, missing)
+		if err != nil {
+			for _, id := range missing {
+				delete(l.profiles, id)
+			}
+			return nil, err
+		}
+		for _, p := range ps {
+			l.profiles[p.ID] = p
+		}
+	}
+	rv := make(map[uint]*Profile, len(ids))
+	for _, id := range ids {
+		if p := l.profiles[id]; p != nil {
+			rv[id] = p
+		}
+	}
+	return rv, nil
+}
+
+// ListFollowers returns a page of the users following username, as seen by
+// uid, and how many there are.
+func (s *ProfileUsecase) ListFollowers(ctx context.Context, uid uint, username string, limit, offset int64) ([]*Profile, int64, error) {
+	p, err := s.visibleProfile(ctx, uid, username)
+	if err != nil {
+		return nil, 0, err
+	}
+	rv, err := s.listProfiles(ctx, uid, limit, offset, p.ID, s.repo.Followers)
+	return rv, int64(p.FollowersCount), err
+}
+
+// ListFollowing is ListFollowers for the users username follows.
+func (s *ProfileUsecase) ListFollowing(ctx context.Context, uid uint, username string, limit, offset int64) ([]*Profile, int64, error) {
+	p, err := s.visibleProfile(ctx, uid, username)
+	if err != nil {
+		return nil, 0, err
+	}
+	rv, err := s.listProfiles(ctx, uid, limit, offset, p.ID, s.repo.Followed)
+	return rv, int64(p.FollowingCount), err
+}
+
+// listProfiles returns the profiles of the page of ids that list gives for
+// user id, in its order.
+func (s *ProfileUsecase) listProfiles(ctx context.Context, uid uint, limit, offset int64, id uint,
+	list func(ctx context.Context, uid uint, limit, offset int64) ([]uint, error)) ([]*Profile, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	ids, err := list(ctx, id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := NewProfileLoader(s.repo, uid).Load(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*Profile, 0, len(ids))
+	for _, id := range ids {
+		if p := profiles[id]; p != nil {
+			rv = append(rv, p)
+		}
+	}
+	return rv, nil
 }
 
 func (s *ProfileUsecase) GetProfile(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
-	return s.repo.GetProfile(ctx, uid, username)
+	return s.visibleProfile(ctx, uid, username)
 }
 
+// FollowUser follows username and fills the timeline of uid with the latest
+// articles of username. The follow stands if that fails. Following a private
+// user only asks them to approve it.
 func (s *ProfileUsecase) FollowUser(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
-	return s.repo.FollowUser(ctx, uid, username)
+	p, err := s.visibleProfile(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	if p.Private && !p.Following && p.ID != uid {
+		return s.repo.RequestFollow(ctx, uid, username)
+	}
+	rv, err = s.repo.FollowUser(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tl.Backfill(ctx, uid, rv.ID); err != nil {
+		s.log.WithContext(ctx).Errorf("backfill timeline of %d with %d: %v", uid, rv.ID, err)
+	}
+	return rv, nil
 }
 
 func (s *ProfileUsecase) UnFollowUser(ctx context.Context, uid uint, username string) (rv *Profile, err error) {
-	return s.repo.UnFollowUser(ctx, uid, username)
+	rv, err = s.repo.UnFollowUser(ctx, uid, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tl.RemoveAuthor(ctx, uid, rv.ID); err != nil {
+		s.log.WithContext(ctx).Errorf("remove %d from timeline of %d: %v", rv.ID, uid, err)
+	}
+	return rv, nil
 }
@@ -0,0 +1,189 @@
+package biz_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestFollowCounts(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	carol := app.register(t, "carol")
+	for _, name := range []string{"bob", "carol"} {
+		if _, err := app.profiles.FollowUser(alice, uid(alice), name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p, err := app.profiles.FollowUser(bob, uid(bob), "carol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Following || p.FollowersCount != 2 {
+		t.Errorf("carol: following %v, followers %d", p.Following, p.FollowersCount)
+	}
+	// 重复关注不重复计数
+	if p, err = app.profiles.FollowUser(bob, uid(bob), "carol"); err != nil || p.FollowersCount != 2 {
+		t.Errorf("follow again: followers %d, %v", p.FollowersCount, err)
+	}
+	names := func(rv []*biz.Profile) (names []string) {
+		for _, p := range rv {
+			names = append(names, p.Username)
+		}
+		return names
+	}
+	rv, count, err := app.profiles.ListFollowers(context.Background(), 0, "carol", 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"bob", "alice"}; count != 2 || !reflect.DeepEqual(names(rv), want) {
+		t.Errorf("followers of carol = %q (%d), want %q", names(rv), count, want)
+	}
+	if rv, count, err = app.profiles.ListFollowing(context.Background(), 0, "alice", 1, 1); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"bob"}; count != 2 || !reflect.DeepEqual(names(rv), want) {
+		t.Errorf("second followed by alice = %q (%d), want %q", names(rv), count, want)
+	}
+
+	if p, err = app.profiles.UnFollowUser(alice, uid(alice), "carol"); err != nil || p.Following || p.FollowersCount != 1 {
+		t.Errorf("unfollow: %+v, %v", p, err)
+	}
+	if p, err = app.profiles.UnFollowUser(alice, uid(alice), "carol"); err != nil || p.FollowersCount != 1 {
+		t.Errorf("unfollow again: followers %d, %v", p.FollowersCount, err)
+	}
+	if p, err = app.profiles.GetProfile(context.Background(), 0, "alice"); err != nil || p.FollowingCount != 1 {
+		t.Errorf("alice follows %d, %v", p.FollowingCount, err)
+	}
+
+	app.createArticle(t, carol, "By carol")
+	if p, err = app.profiles.GetProfile(context.Background(), 0, "carol"); err != nil || p.ArticlesCount != 1 {
+		t.Errorf("carol has %d articles, %v", p.ArticlesCount, err)
+	}
+}
+
+func TestBlockAndMute(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	a := app.createArticle(t, alice, "Dragons")
+	if _, err := app.profiles.FollowUser(bob, uid(bob), "alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := app.profiles.BlockUser(alice, uid(alice), "alice"); err != biz.ErrSelf {
+		t.Errorf("blocking oneself: %v", err)
+	}
+	p, err := app.profiles.BlockUser(alice, uid(alice), "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Blocking || p.Following {
+		t.Errorf("bob as seen by alice: blocking %v, following %v", p.Blocking, p.Following)
+	}
+	if _, err := app.profiles.GetProfile(bob, uid(bob), "alice"); !errors.IsNotFound(err) {
+		t.Errorf("bob gets alice: %v, want not found", err)
+	}
+	if _, err := app.profiles.FollowUser(bob, uid(bob), "alice"); !errors.IsNotFound(err) {
+		t.Errorf("bob follows alice: %v, want not found", err)
+	}
+	if _, err := app.social.AddComment(bob, a.Slug, &biz.Comment{Body: "hi"}); !errors.IsForbidden(err) {
+		t.Errorf("bob comments: %v, want forbidden", err)
+	}
+	if _, err := app.social.FavoriteArticle(bob, a.Slug); !errors.IsForbidden(err) {
+		t.Errorf("bob favorites: %v, want forbidden", err)
+	}
+
+	if _, err := app.profiles.MuteUser(bob, uid(bob), "alice"); err != nil {
+		t.Fatal(err)
+	}
+	rv, _, _, err := app.social.ListArticles(bob, biz.ArticleQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rv) != 0 {
+		t.Errorf("bob lists %q with alice muted", slugs(rv))
+	}
+	if _, err := app.profiles.UnmuteUser(bob, uid(bob), "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if rv, _, _, err = app.social.ListArticles(bob, biz.ArticleQuery{}); err != nil || len(rv) != 1 {
+		t.Errorf("bob lists %q after unmuting alice: %v", slugs(rv), err)
+	}
+
+	if _, err := app.profiles.UnblockUser(alice, uid(alice), "bob"); err != nil {
+		t.Fatal(err)
+	}
+	// 拉黑取消了关注, 解除后不会恢复
+	p, err = app.profiles.GetProfile(bob, uid(bob), "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Following || p.FollowersCount != 0 {
+		t.Errorf("alice as seen by bob after unblock: following %v, followers %d", p.Following, p.FollowersCount)
+	}
+	if _, err := app.social.FavoriteArticle(bob, a.Slug); err != nil {
+		t.Errorf("bob favorites after unblock: %v", err)
+	}
+}
+
+func TestPrivateAccount(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	a := app.createArticle(t, alice, "Dragons")
+	private := true
+	if _, err := app.users.UpdateUser(alice, uid(alice), &biz.UpdateUser{Private: &private}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := app.social.GetArticle(bob, a.Slug); !errors.IsNotFound(err) {
+		t.Errorf("bob gets the article: %v, want not found", err)
+	}
+	if _, err := app.social.GetArticle(alice, a.Slug); err != nil {
+		t.Errorf("alice gets the article: %v", err)
+	}
+	p, err := app.profiles.FollowUser(bob, uid(bob), "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Following || !p.Requested {
+		t.Errorf("after asking: following %v, requested %v", p.Following, p.Requested)
+	}
+	rv, count, err := app.profiles.ListFollowRequests(alice, uid(alice), 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 || len(rv) != 1 || rv[0].Username != "bob" {
+		t.Errorf("requests = %+v (%d)", rv, count)
+	}
+	if _, err := app.profiles.ApproveFollowRequest(alice, uid(alice), "bob"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := app.profiles.ApproveFollowRequest(alice, uid(alice), "bob"); err != biz.ErrFollowRequestNotFound {
+		t.Errorf("approving twice: %v", err)
+	}
+	if _, err := app.social.GetArticle(bob, a.Slug); err != nil {
+		t.Errorf("approved bob gets the article: %v", err)
+	}
+	articles, _, _, err := app.social.ListArticles(context.Background(), biz.ArticleQuery{})
+	if err != nil || len(articles) != 0 {
+		t.Errorf("anonymous list = %q, %v", slugs(articles), err)
+	}
+
+	carol := app.register(t, "carol")
+	if _, err := app.profiles.FollowUser(carol, uid(carol), "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if p, err = app.profiles.DenyFollowRequest(alice, uid(alice), "carol"); err != nil {
+		t.Fatal(err)
+	}
+	if p, err = app.profiles.GetProfile(carol, uid(carol), "alice"); err != nil || p.Following || p.Requested {
+		t.Errorf("alice as seen by denied carol: %+v, %v", p, err)
+	}
+	if _, err := app.social.GetArticle(carol, a.Slug); !errors.IsNotFound(err) {
+		t.Errorf("denied carol gets the article: %v, want not found", err)
+	}
+}
@@ -0,0 +1,115 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/pkg/middleware/auth"
+)
+
+// ArticleStatus is where an article is in its publishing workflow.
+type ArticleStatus string
+
+const (
+	// StatusDraft articles are only seen by their author.
+	StatusDraft ArticleStatus = "draft"
+	// StatusScheduled articles are published by PublishDue at their PublishAt.
+	StatusScheduled ArticleStatus = "scheduled"
+	// StatusPublished articles are seen by everyone.
+	StatusPublished ArticleStatus = "published"
+)
+
+// ErrArticleNotFound is returned for articles that don't exist, and for the
+// unpublished ones of other users.
+var ErrArticleNotFound = errors.NotFound("article", "article not found")
+
+// visibleTo reports whether user uid may see the article.
+func (o *Article) visibleTo(uid uint) bool {
+	return o.Status == StatusPublished || o.verifyAuthor(uid)
+}
+
+// visibleArticle returns the article with slug if the current user may see it.
+func (uc *SocialUsecase) visibleArticle(ctx context.Context, slug string) (*Article, error) {
+	a, err := uc.ar.Get(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !a.visibleTo(auth.GetUserIdOrNotLogin(ctx)) {
+		return nil, ErrArticleNotFound
+	}
+	ok, err := uc.seesArticle(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrArticleNotFound
+	}
+	return a, nil
+}
+
+// schedule sets the status and PublishAt of a new article from what was asked
+// for. Drafts have no PublishAt, a PublishAt in the future schedules the
+// article, and anything else publishes it now.
+func schedule(in *Article, now time.Time) error {
+	switch in.Status {
+	case StatusDraft:
+		in.PublishAt = time.Time{}
+		return nil
+	case StatusScheduled:
+		if in.PublishAt.IsZero() {
+			return errors.New(422, "publishAt", "is required to schedule an article")
+		}
+	case "", StatusPublished:
+	default:
+		return errors.New(422, "status", "must be draft, scheduled or published")
+	}
+	if in.PublishAt.After(now) {
+		in.Status = StatusScheduled
+		return nil
+	}
+	in.Status, in.PublishAt = StatusPublished, now
+	return nil
+}
+
+// reschedule is schedule for an update of a. What in leaves empty keeps the
+// value of a, and an article that stays published keeps its publish time.
+func reschedule(a, in *Article, now time.Time) error {
+	if in.Status == "" {
+		in.Status = a.Status
+		if in.PublishAt.IsZero() {
+			in.PublishAt = a.PublishAt
+		}
+	}
+	if in.Status == StatusPublished && a.Status == StatusPublished {
+		in.PublishAt = a.PublishAt
+		return nil
+	}
+	return schedule(in, now)
+}
+
+// ListMyDrafts returns the draft and scheduled articles of the current user.
+func (uc *SocialUsecase) ListMyDrafts(ctx context.Context, q ArticleQuery) (rv []*Article, count int64, next string, err error) {
+	q.AuthorID = auth.FromContext(ctx).UserID
+	q.Unpublished = true
+	rv, count, next, err = uc.listArticles(ctx, q)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if err := uc.fillAuthors(ctx, rv...); err != nil {
+		return nil, 0, "", err
+	}
+	return rv, count, next, nil
+}
+
+// PublishDue publishes the scheduled articles whose PublishAt has come and
+// returns them.
+func (uc *SocialUsecase) PublishDue(ctx context.Context, now time.Time) ([]*Article, error) {
+	rv, err := uc.ar.PublishDue(ctx, now)
+	for _, a := range rv {
+		uc.indexArticle(ctx, a)
+		uc.pushArticle(ctx, a)
+		uc.recountArticles(ctx, a.AuthorUserID)
+	}
+	return rv, err
+}
@@ -0,0 +1,117 @@
+package biz_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestDraftsAndScheduling(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	create := func(title string, status biz.ArticleStatus, at time.Time) *biz.Article {
+		t.Helper()
+		rv, err := app.social.CreateArticle(alice, &biz.Article{Title: title, Body: "body", Status: status, PublishAt: at})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rv
+	}
+	draft := create("Draft", biz.StatusDraft, time.Time{})
+	later := create("Later", "", publishAt)
+	now := create("Now", "", time.Time{})
+	if draft.Status != biz.StatusDraft || later.Status != biz.StatusScheduled || now.Status != biz.StatusPublished {
+		t.Fatalf("statuses = %s %s %s", draft.Status, later.Status, now.Status)
+	}
+	if !draft.PublishAt.IsZero() || !later.PublishAt.Equal(publishAt) || now.PublishAt.IsZero() {
+		t.Errorf("publish times = %v %v %v", draft.PublishAt, later.PublishAt, now.PublishAt)
+	}
+	for _, in := range []*biz.Article{
+		{Title: "No time", Status: biz.StatusScheduled},
+		{Title: "Bogus", Status: "bogus"},
+	} {
+		if _, err := app.social.CreateArticle(alice, in); errors.Code(err) != 422 {
+			t.Errorf("create %s: %v, want 422", in.Status, err)
+		}
+	}
+
+	// 未发布的只有作者看得到
+	visible := func(ctx context.Context, want ...string) {
+		t.Helper()
+		rv, count, _, err := app.social.ListArticles(ctx, biz.ArticleQuery{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != int64(len(want)) || !reflect.DeepEqual(slugs(rv), want) {
+			t.Errorf("ListArticles = %q (%d), want %q", slugs(rv), count, want)
+		}
+		if rv, _, _, err = app.social.FeedArticles(bob, biz.ArticleQuery{}); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(slugs(rv), want) {
+			t.Errorf("feed = %q, want %q", slugs(rv), want)
+		}
+	}
+	if _, err := app.profiles.FollowUser(bob, uid(bob), "alice"); err != nil {
+		t.Fatal(err)
+	}
+	visible(bob, "now")
+	visible(context.Background(), "now")
+	for _, ctx := range []context.Context{bob, context.Background()} {
+		for _, slug := range []string{"draft", "later"} {
+			if _, err := app.social.GetArticle(ctx, slug); !errors.IsNotFound(err) {
+				t.Errorf("GetArticle(%s) of another user: %v, want not found", slug, err)
+			}
+		}
+	}
+	if _, err := app.social.UpdateArticle(bob, &biz.Article{Slug: "draft", Body: "mine"}); !errors.IsNotFound(err) {
+		t.Errorf("UpdateArticle of a draft of another user: %v, want not found", err)
+	}
+	if _, err := app.social.GetArticle(alice, "draft"); err != nil {
+		t.Errorf("author can't see the draft: %v", err)
+	}
+
+	rv, count, _, err := app.social.ListMyDrafts(alice, biz.ArticleQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"later", "draft"}; count != 2 || !reflect.DeepEqual(slugs(rv), want) {
+		t.Errorf("ListMyDrafts = %q (%d), want %q", slugs(rv), count, want)
+	}
+	if rv, _, _, err = app.social.ListMyDrafts(bob, biz.ArticleQuery{}); err != nil || len(rv) != 0 {
+		t.Errorf("ListMyDrafts of bob = %q, %v", slugs(rv), err)
+	}
+
+	// 到点才发布, 且只发布一次
+	if rv, err = app.social.PublishDue(context.Background(), publishAt.Add(-time.Second)); err != nil || len(rv) != 0 {
+		t.Errorf("PublishDue before publishAt = %q, %v", slugs(rv), err)
+	}
+	if rv, err = app.social.PublishDue(context.Background(), publishAt); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(slugs(rv), []string{"later"}) || rv[0].Status != biz.StatusPublished {
+		t.Errorf("PublishDue at publishAt = %q", slugs(rv))
+	}
+	if rv, err = app.social.PublishDue(context.Background(), publishAt.Add(time.Hour)); err != nil || len(rv) != 0 {
+		t.Errorf("PublishDue again = %q, %v", slugs(rv), err)
+	}
+	visible(bob, "now", "later")
+	a, err := app.social.GetArticle(bob, "later")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.PublishAt.Equal(publishAt) {
+		t.Errorf("published at %v, want %v", a.PublishAt, publishAt)
+	}
+
+	if _, err := app.social.UpdateArticle(alice, &biz.Article{Slug: "draft", Status: biz.StatusPublished}); err != nil {
+		t.Fatal(err)
+	}
+	visible(bob, "now", "later", "draft")
+}
@@ -1,46 +1,142 @@
 package biz
 
-import "gorm.io/gorm"
+import (
+	"time"
 
-type ListOptions struct {
-	Favorited string
-	Tag       string
-}
+	"github.com/go-kratos/kratos/v2/errors"
+)
 
-type DbOption func(*gorm.DB) *gorm.DB
+// DefaultLimit is the page size of article lists that don't ask for one.
+const DefaultLimit = 20
 
-func DbOffset(offset int64) func(db *gorm.DB) *gorm.DB {
-	return func(db *gorm.DB) *gorm.DB {
-		return db.Offset(int(offset))
-	}
+// ArticleSort is what articles are listed by. Articles that tie are listed
+// by creation, in the same direction.
+type ArticleSort int
+
+const (
+	// SortCreated lists articles by creation, the default.
+	SortCreated ArticleSort = iota
+	SortUpdated
+	SortFavorites
+	SortComments
+)
+
+// articleSorts are the names of the sorts in the API.
+var articleSorts = map[string]ArticleSort{
+	"":               SortCreated,
+	"createdAt":      SortCreated,
+	"updatedAt":      SortUpdated,
+	"favoritesCount": SortFavorites,
+	"commentsCount":  SortComments,
 }
-func DbLimit(size int64) func(db *gorm.DB) *gorm.DB {
-	return func(db *gorm.DB) *gorm.DB {
-		return db.Limit(int(size))
+
+// ParseArticleSort returns the sort named sort and whether order, asc or
+// desc, is ascending. Both default to the latest created first.
+func ParseArticleSort(sort, order string) (ArticleSort, bool, error) {
+	s, ok := articleSorts[sort]
+	if !ok {
+		return 0, false, errors.New(422, "sort", "must be createdAt, updatedAt, favoritesCount or commentsCount")
+	}
+	switch order {
+	case "", "desc":
+		return s, false, nil
+	case "asc":
+		return s, true, nil
+	default:
+		return 0, false, errors.New(422, "order", "must be asc or desc")
 	}
 }
 
-func DbUserId(uid uint) func(db *gorm.DB) *gorm.DB {
-	return func(db *gorm.DB) *gorm.DB {
-		return db.Where("user_id", uid)
+// ParseTagMatch returns whether match, any or all, asks for articles with
+// all of the tags. It defaults to any.
+func ParseTagMatch(match string) (bool, error) {
+	switch match {
+	case "", "any":
+		return false, nil
+	case "all":
+		return true, nil
+	default:
+		return false, errors.New(422, "tagMatch", "must be any or all")
 	}
 }
 
-func DbAuthorId(uid uint) func(db *gorm.DB) *gorm.DB {
-	return func(db *gorm.DB) *gorm.DB {
-		if uid > 0 {
-			return db.Where("author_id", uid)
-		}
-		return db
-	}
+// ArticleQuery selects a page of articles. Empty fields don't filter, and
+// every ArticleRepo translates it to its own storage.
+type ArticleQuery struct {
+	// IDs keeps the articles with these ids.
+	IDs []uint
+	// Author is the username of the author.
+	Author   string
+	AuthorID uint
+	// FeedOf keeps the articles in the feed of this user: the ones in its
+	// timeline and the unpushed ones of the authors it follows.
+	FeedOf uint
+	// MutedBy drops the articles whose owner this user muted.
+	MutedBy uint
+	// HidePrivate drops the articles of private owners, except for Viewer
+	// when it is an author or follows the owner. Viewer is 0 for anonymous.
+	HidePrivate bool
+	Viewer      uint
+	// Tags keeps the articles with any of the tags, or with all of them if
+	// AllTags is set. ExcludeTags drops the articles with any of its tags.
+	Tags        []string
+	AllTags     bool
+	ExcludeTags []string
+	// CreatedAfter and CreatedBefore keep the articles created at or after
+	// the one and before the other.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	// FavoritedBy is the username of a user who favorited the articles.
+	FavoritedBy string
+	// SeriesID keeps the articles of a series, which are then listed in the
+	// order of the series whatever Sort.
+	SeriesID uint
+	// Unpublished lists the draft and scheduled articles instead of the
+	// published ones.
+	Unpublished bool
+
+	Limit     int64
+	Offset    int64
+	Sort      ArticleSort
+	Ascending bool
+	// Cursor is the nextCursor of the previous page, to continue the list
+	// after it instead of at Offset. ArticleRepo gets it as AfterID.
+	Cursor string
+	// AfterID continues the list after this article, in the order of the
+	// list, and the list is not counted then. The article is not looked up:
+	// AfterUpdated is when it was updated for SortUpdated, AfterKey its
+	// SortKey for the keyed sorts.
+	AfterID      uint
+	AfterUpdated time.Time
+	AfterKey     int64
 }
 
-func DbAuthor(username string) func(db *gorm.DB) *gorm.DB {
+// KeyedSort reports whether q lists articles by a count or by their position
+// in the series, which ArticleRepo.List then sets as Article.SortKey.
+func (q ArticleQuery) KeyedSort() bool {
+	return q.SeriesID > 0 || q.Sort == SortFavorites || q.Sort == SortComments
+}
 
-	return func(db *gorm.DB) *gorm.DB {
-		if len(username) > 0 {
-			return db.Where("username", username)
+// CleanTags returns names without blanks and repeats, in order.
+func CleanTags(names ...string) []string {
+	var rv []string
+	seen := make(map[string]bool)
+	for _, x := range names {
+		if x != "" && !seen[x] {
+			seen[x] = true
+			rv = append(rv, x)
 		}
-		return db
 	}
+	return rv
+}
+
+// CommentQuery selects the comments of an article.
+type CommentQuery struct {
+	ArticleID uint
+	// AfterID keeps the comments after this one.
+	AfterID uint
+	// Limit is the most comments to return, all of them if 0.
+	Limit int
+	// MutedBy drops the comments of the users this user muted.
+	MutedBy uint
 }
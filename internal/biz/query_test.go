@@ -0,0 +1,35 @@
+package biz_test
+
+import (
+	"testing"
+
+	"realworld/internal/biz"
+)
+
+func TestParseArticleSort(t *testing.T) {
+	for _, c := range []struct {
+		sort, order string
+		want        biz.ArticleSort
+		asc, ok     bool
+	}{
+		{"", "", biz.SortCreated, false, true},
+		{"updatedAt", "asc", biz.SortUpdated, true, true},
+		{"favoritesCount", "desc", biz.SortFavorites, false, true},
+		{"commentsCount", "", biz.SortComments, false, true},
+		{"title", "", 0, false, false},
+		{"", "up", 0, false, false},
+	} {
+		s, asc, err := biz.ParseArticleSort(c.sort, c.order)
+		if (err == nil) != c.ok || s != c.want || asc != c.asc {
+			t.Errorf("ParseArticleSort(%q, %q) = %v, %v, %v", c.sort, c.order, s, asc, err)
+		}
+	}
+	for match, want := range map[string]bool{"": false, "any": false, "all": true} {
+		if all, err := biz.ParseTagMatch(match); all != want || err != nil {
+			t.Errorf("ParseTagMatch(%q) = %v, %v", match, all, err)
+		}
+	}
+	if _, err := biz.ParseTagMatch("some"); err == nil {
+		t.Error("ParseTagMatch accepted some")
+	}
+}
@@ -0,0 +1,170 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// wordsPerMinute is the reading speed ReadingTime assumes.
+const wordsPerMinute = 200
+
+// Rendering is the HTML of an article body and what is derived from it.
+type Rendering struct {
+	// HTML is sanitized, it can be put in a page as is.
+	HTML string
+	TOC  []Heading
+	// WordCount counts runs of letters and digits, and every CJK character
+	// on its own.
+	WordCount uint32
+	// ReadingTime is in minutes, rounded up.
+	ReadingTime uint32
+}
+
+// Heading is an entry of the table of contents, ID being the id of the
+// heading element in the HTML.
+type Heading struct {
+	Level int
+	ID    string
+	Text  string
+}
+
+var (
+	markdown = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+
+	// sanitizer also lets through the ids of headings, which headingIDs may
+	// make of any letter, and the language of code blocks.
+	sanitizer = func() *bluemonday.Policy {
+		p := bluemonday.UGCPolicy()
+		p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}-]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+		p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#-]+$`)).OnElements("code")
+		return p
+	}()
+
+	textOnly = bluemonday.StrictPolicy()
+)
+
+// RenderMarkdown renders body, GitHub flavored Markdown, to sanitized HTML.
+// Raw HTML in body is dropped.
+func RenderMarkdown(body string) (*Rendering, error) {
+	src := []byte(body)
+	ctx := parser.NewContext(parser.WithIDs(&headingIDs{seen: make(map[string]bool)}))
+	doc := markdown.Parser().Parse(text.NewReader(src), parser.WithContext(ctx))
+	var b bytes.Buffer
+	if err := markdown.Renderer().Render(&b, src, doc); err != nil {
+		return nil, err
+	}
+	rv := &Rendering{HTML: sanitizer.Sanitize(b.String()), TOC: make([]Heading, 0)}
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		h, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		id, _ := h.AttributeString("id")
+		idb, _ := id.([]byte)
+		rv.TOC = append(rv.TOC, Heading{Level: h.Level, ID: string(idb), Text: nodeText(h, src)})
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	rv.WordCount = countWords(html.UnescapeString(textOnly.Sanitize(rv.HTML)))
+	rv.ReadingTime = (rv.WordCount + wordsPerMinute - 1) / wordsPerMinute
+	return rv, nil
+}
+
+// RenderPreview renders a body the way it would be rendered when saved.
+func (uc *SocialUsecase) RenderPreview(ctx context.Context, body string) (*Rendering, error) {
+	return RenderMarkdown(body)
+}
+
+// render sets the Rendering of an article whose body is set.
+func render(in *Article) error {
+	if in.Body == "" {
+		return nil
+	}
+	r, err := RenderMarkdown(in.Body)
+	if err != nil {
+		return fmt.Errorf("render article body: %w", err)
+	}
+	in.Rendering = r
+	return nil
+}
+
+// nodeText is the plain text of the inline children of n.
+func nodeText(n ast.Node, src []byte) string {
+	var b strings.Builder
+	_ = ast.Walk(n, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch t := n.(type) {
+		case *ast.Text:
+			b.Write(t.Segment.Value(src))
+			if t.SoftLineBreak() || t.HardLineBreak() {
+				b.WriteByte(' ')
+			}
+		case *ast.String:
+			b.Write(t.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return strings.TrimSpace(b.String())
+}
+
+func countWords(s string) uint32 {
+	var n uint32
+	inWord := false
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+			n++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if !inWord {
+				n++
+			}
+			inWord = true
+		default:
+			inWord = false
+		}
+	}
+	return n
+}
+
+// headingIDs gives headings slugify'd ids, which keep non-ASCII letters where
+// the ids of goldmark keep ASCII only, with a numeric suffix for repeats.
+type headingIDs struct {
+	seen map[string]bool
+}
+
+func (s *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	base := strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(string(value)), "-"), "-")
+	if base == "" {
+		base = "heading"
+	}
+	id := base
+	for i := 1; s.seen[id]; i++ {
+		id = fmt.Sprintf("%s-%d", base, i)
+	}
+	s.seen[id] = true
+	return []byte(id)
+}
+
+func (s *headingIDs) Put(value []byte) {
+	s.seen[string(value)] = true
+}
@@ -0,0 +1,113 @@
+package biz_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"realworld/internal/biz"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		body     string
+		contains []string
+		excludes []string
+		toc      []biz.Heading
+		words    uint32
+		minutes  uint32
+	}{
+		{
+			name:     "script",
+			body:     "hello <script>alert(1)</script>\n\n<script>alert(2)</script>",
+			contains: []string{"<p>hello alert(1)</p>"},
+			excludes: []string{"<script", "alert(2)"},
+			words:    3, minutes: 1,
+		},
+		{
+			name:     "javascript link",
+			body:     "[click](javascript:alert(1)) and <a href=\"javascript:alert(2)\">raw</a>",
+			contains: []string{"click"},
+			excludes: []string{"javascript:", "href"},
+			words:    3, minutes: 1,
+		},
+		{
+			name:     "onerror",
+			body:     "<img src=x onerror=alert(1)>\n\n![cat](cat.png)",
+			contains: []string{`<img src="cat.png" alt="cat"`},
+			excludes: []string{"onerror", "alert"},
+			words:    0, minutes: 0,
+		},
+		{
+			name:     "toc",
+			body:     "# Intro\n\ntext\n\n## Détails *en* gras\n\n## Intro\n\n```go\nfunc main() {}\n```",
+			contains: []string{`<h1 id="intro">Intro</h1>`, `<h2 id="détails-en-gras">`, `<h2 id="intro-1">`, `class="language-go"`},
+			toc: []biz.Heading{
+				{Level: 1, ID: "intro", Text: "Intro"},
+				{Level: 2, ID: "détails-en-gras", Text: "Détails en gras"},
+				{Level: 2, ID: "intro-1", Text: "Intro"},
+			},
+			words: 8, minutes: 1,
+		},
+		{
+			name:  "words",
+			body:  "It's 2024, **bold** and `code`: 你好世界",
+			words: 10, minutes: 1,
+		},
+		{
+			name:  "reading time",
+			body:  strings.Repeat("word ", 401),
+			words: 401, minutes: 3,
+		},
+		{
+			name: "empty",
+			toc:  []biz.Heading{},
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			r, err := biz.RenderMarkdown(c.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range c.contains {
+				if !strings.Contains(r.HTML, s) {
+					t.Errorf("HTML %q lacks %q", r.HTML, s)
+				}
+			}
+			for _, s := range c.excludes {
+				if strings.Contains(r.HTML, s) {
+					t.Errorf("HTML %q has %q", r.HTML, s)
+				}
+			}
+			if c.toc == nil {
+				c.toc = []biz.Heading{}
+			}
+			if !reflect.DeepEqual(r.TOC, c.toc) {
+				t.Errorf("TOC = %+v, want %+v", r.TOC, c.toc)
+			}
+			if r.WordCount != c.words || r.ReadingTime != c.minutes {
+				t.Errorf("%d words, %d minutes, want %d, %d", r.WordCount, r.ReadingTime, c.words, c.minutes)
+			}
+		})
+	}
+}
+
+func TestCreateArticleRenders(t *testing.T) {
+	app := newTestApp(t)
+	alice := app.register(t, "alice")
+	a, err := app.social.CreateArticle(alice, &biz.Article{Title: "Dragons", Body: "# Fire\n\nhot <script>x</script>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Rendering == nil || !strings.Contains(a.Rendering.HTML, `<h1 id="fire">Fire</h1>`) || strings.Contains(a.Rendering.HTML, "script") {
+		t.Fatalf("rendering = %+v", a.Rendering)
+	}
+	got, err := app.social.GetArticle(alice, a.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Rendering, a.Rendering) {
+		t.Errorf("GetArticle rendering = %+v, want %+v", got.Rendering, a.Rendering)
+	}
+}
@@ -0,0 +1,192 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/pkg/middleware/auth"
+)
+
+// Revision is the content of an article after its creation or one of its
+// edits. Revisions are never changed once stored.
+type Revision struct {
+	ID        uint
+	ArticleID uint
+	// Number counts the revisions of an article, 1 is its creation.
+	Number      uint32
+	Title       string
+	Description string
+	Body        string
+	TagList     []string
+	CreatedAt   time.Time
+	// RestoredFrom is the number of the revision this one restored, 0 if none.
+	RestoredFrom uint32
+
+	AuthorID uint
+	Author   *Profile
+}
+
+type RevisionRepo interface {
+	// Create stores r as the next revision of its article and sets its Number.
+	Create(ctx context.Context, r *Revision) (*Revision, error)
+	// List returns the revisions of an article, the latest first.
+	List(ctx context.Context, articleID uint) ([]*Revision, error)
+	Get(ctx context.Context, articleID uint, number uint32) (*Revision, error)
+}
+
+// RevisionDiff is what changed between two revisions of an article.
+type RevisionDiff struct {
+	From *Revision
+	To   *Revision
+	// Body is the line diff of the bodies.
+	Body        []DiffLine
+	TagsAdded   []string
+	TagsRemoved []string
+}
+
+func newRevision(a *Article, authorID uint) *Revision {
+	return &Revision{
+		ArticleID:   a.ID,
+		Title:       a.Title,
+		Description: a.Description,
+		Body:        a.Body,
+		TagList:     a.TagList,
+		AuthorID:    authorID,
+	}
+}
+
+// articleOfAuthor returns the article with slug if the current user wrote it.
+func (uc *SocialUsecase) articleOfAuthor(ctx context.Context, slug string) (*Article, error) {
+	a, err := uc.visibleArticle(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !a.verifyAuthor(auth.FromContext(ctx).UserID) {
+		return nil, errors.Unauthorized("user", "verifyAuthor fail")
+	}
+	return a, nil
+}
+
+// fillRevisionAuthors is fillAuthors for revisions.
+func (uc *SocialUsecase) fillRevisionAuthors(ctx context.Context, rs ...*Revision) error {
+	ids := make([]uint, len(rs))
+	for i, r := range rs {
+		ids[i] = r.AuthorID
+	}
+	authors, err := uc.profileLoader(ctx).Load(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	for _, r := range rs {
+		r.Author = authors[r.AuthorID]
+		if r.Author == nil {
+			r.Author = &Profile{ID: r.AuthorID}
+		}
+	}
+	return nil
+}
+
+// ListRevisions returns the revisions of the article, which only its author
+// may see, the latest first.
+func (uc *SocialUsecase) ListRevisions(ctx context.Context, slug string) ([]*Revision, error) {
+	a, err := uc.articleOfAuthor(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := uc.rr.List(ctx, a.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillRevisionAuthors(ctx, rv...); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+func (uc *SocialUsecase) GetRevision(ctx context.Context, slug string, number uint32) (*Revision, error) {
+	a, err := uc.articleOfAuthor(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	rv, err := uc.rr.Get(ctx, a.ID, number)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillRevisionAuthors(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+func (uc *SocialUsecase) DiffRevisions(ctx context.Context, slug string, from, to uint32) (*RevisionDiff, error) {
+	a, err := uc.articleOfAuthor(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	x, err := uc.rr.Get(ctx, a.ID, from)
+	if err != nil {
+		return nil, err
+	}
+	y, err := uc.rr.Get(ctx, a.ID, to)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillRevisionAuthors(ctx, x, y); err != nil {
+		return nil, err
+	}
+	return &RevisionDiff{
+		From:        x,
+		To:          y,
+		Body:        diffLines(x.Body, y.Body),
+		TagsAdded:   subtractTags(y.TagList, x.TagList),
+		TagsRemoved: subtractTags(x.TagList, y.TagList),
+	}, nil
+}
+
+// RestoreRevision makes the content of a revision the current version of the
+// article, as a new revision.
+func (uc *SocialUsecase) RestoreRevision(ctx context.Context, slug string, number uint32) (rv *Article, err error) {
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		a, err := uc.articleOfAuthor(ctx, slug)
+		if err != nil {
+			return err
+		}
+		r, err := uc.rr.Get(ctx, a.ID, number)
+		if err != nil {
+			return err
+		}
+		rv, err = uc.updateArticle(ctx, a, &Article{
+			Title:       r.Title,
+			Description: r.Description,
+			Body:        r.Body,
+			TagList:     r.TagList,
+			// 空正文不会渲染, 旧的渲染结果要一起清掉
+			Rendering: &Rendering{},
+		}, r.Number)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.indexArticle(ctx, rv)
+	if err := uc.fillAuthors(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// subtractTags returns the tags of a that are not in b, in the order of a.
+func subtractTags(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, t := range b {
+		in[t] = true
+	}
+	rv := make([]string, 0)
+	for _, t := range a {
+		if !in[t] {
+			rv = append(rv, t)
+		}
+	}
+	return rv
+}
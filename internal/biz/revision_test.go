@@ -0,0 +1,121 @@
+package biz_test
+
+import (
+	"reflect"
+	"testing"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestRevisions(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	a, err := app.social.CreateArticle(alice, &biz.Article{Title: "Dragons", Description: "d", Body: "one\ntwo\nthree", TagList: []string{"a", "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	edits := []*biz.Article{
+		{Slug: a.Slug, Body: "one\n2\nthree", TagList: []string{"b", "c"}},
+		{Slug: a.Slug, Title: "Wyverns", TagList: []string{"b", "c"}},
+	}
+	for _, in := range edits {
+		if a, err = app.social.UpdateArticle(alice, in); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	revs, err := app.social.ListRevisions(alice, a.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var numbers []uint32
+	for _, r := range revs {
+		numbers = append(numbers, r.Number)
+		if r.Author == nil || r.Author.Username != "alice" {
+			t.Errorf("revision %d author = %+v", r.Number, r.Author)
+		}
+	}
+	if want := []uint32{3, 2, 1}; !reflect.DeepEqual(numbers, want) {
+		t.Fatalf("revisions = %v, want %v", numbers, want)
+	}
+	if revs[0].Title != "Wyverns" || revs[0].Body != "one\n2\nthree" {
+		t.Errorf("latest revision = %q %q", revs[0].Title, revs[0].Body)
+	}
+	r, err := app.social.GetRevision(alice, a.Slug, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Title != "Dragons" || !reflect.DeepEqual(r.TagList, []string{"a", "b"}) {
+		t.Errorf("revision 1 = %q %q", r.Title, r.TagList)
+	}
+
+	d, err := app.social.DiffRevisions(alice, a.Slug, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantBody := []biz.DiffLine{
+		{Op: biz.DiffEqual, Text: "one"},
+		{Op: biz.DiffDelete, Text: "two"},
+		{Op: biz.DiffInsert, Text: "2"},
+		{Op: biz.DiffEqual, Text: "three"},
+	}
+	if !reflect.DeepEqual(d.Body, wantBody) {
+		t.Errorf("body diff = %+v, want %+v", d.Body, wantBody)
+	}
+	if !reflect.DeepEqual(d.TagsAdded, []string{"c"}) || !reflect.DeepEqual(d.TagsRemoved, []string{"a"}) {
+		t.Errorf("tags added %q, removed %q", d.TagsAdded, d.TagsRemoved)
+	}
+
+	// 恢复也是一个新的修订
+	rv, err := app.social.RestoreRevision(alice, a.Slug, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rv.Title != "Dragons" || rv.Body != "one\ntwo\nthree" || !reflect.DeepEqual(rv.TagList, []string{"a", "b"}) {
+		t.Errorf("restored %q %q %q", rv.Title, rv.Body, rv.TagList)
+	}
+	if revs, err = app.social.ListRevisions(alice, rv.Slug); err != nil {
+		t.Fatal(err)
+	}
+	if len(revs) != 4 || revs[0].RestoredFrom != 1 {
+		t.Errorf("%d revisions, the latest restored from %d", len(revs), revs[0].RestoredFrom)
+	}
+
+	if _, err := app.social.ListRevisions(bob, rv.Slug); !errors.IsUnauthorized(err) {
+		t.Errorf("revisions of another author: %v, want unauthorized", err)
+	}
+	if _, err := app.social.RestoreRevision(bob, rv.Slug, 2); !errors.IsUnauthorized(err) {
+		t.Errorf("restore by another author: %v, want unauthorized", err)
+	}
+}
+
+func TestRestoreRevisionWithEmptyFields(t *testing.T) {
+	app := newTestApp(t)
+	alice := app.register(t, "alice")
+	a, err := app.social.CreateArticle(alice, &biz.Article{Title: "first", Body: ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := app.social.UpdateArticle(alice, &biz.Article{Slug: a.Slug, Description: "description", Body: "# body"}); err != nil {
+		t.Fatal(err)
+	}
+	rv, err := app.social.RestoreRevision(alice, a.Slug, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rv.Description != "" || rv.Body != "" {
+		t.Errorf("restored description %q, body %q, want both empty", rv.Description, rv.Body)
+	}
+	if rv.Rendering != nil && (rv.Rendering.HTML != "" || len(rv.Rendering.TOC) != 0) {
+		t.Errorf("restored rendering %+v, want none", rv.Rendering)
+	}
+	revs, err := app.social.ListRevisions(alice, a.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revs) != 3 {
+		t.Fatalf("%d revisions, want 3", len(revs))
+	}
+}
@@ -0,0 +1,225 @@
+package biz
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/pkg/middleware/auth"
+)
+
+// SearchQuery looks for Text in the title, description and body of the
+// published articles matching the filters of ArticleQuery. Its ordering and
+// cursor fields are not used, hits come in the order of their score.
+type SearchQuery struct {
+	ArticleQuery
+	Text string
+}
+
+// SearchHit is an article found by a SearchIndex, better ones score higher.
+type SearchHit struct {
+	ArticleID uint
+	Score     float64
+}
+
+// SearchResult is a found article with the fragments of its fields that
+// matched, HTML escaped and with the matching words in <mark>, by field name.
+type SearchResult struct {
+	Article    *Article
+	Score      float64
+	Highlights map[string]string
+}
+
+// SearchIndex finds articles by their text. Indexes that query the database
+// ignore Index and Remove, the others keep their own copy of the published
+// articles with them.
+type SearchIndex interface {
+	// Search returns a page of hits, the best first, and the number of them.
+	Search(ctx context.Context, q SearchQuery) ([]SearchHit, int64, error)
+	// Index adds or replaces an article, and removes it if it is not published.
+	Index(ctx context.Context, a *Article) error
+	Remove(ctx context.Context, id uint) error
+}
+
+// snippetRunes is about the length of a highlighted fragment.
+const snippetRunes = 160
+
+var wordChars = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+// SearchTerms splits a query into the lowercase words it searches for.
+func SearchTerms(text string) []string {
+	var rv []string
+	seen := make(map[string]bool)
+	for _, t := range nonSlugChars.Split(strings.ToLower(text), -1) {
+		if t != "" && !seen[t] {
+			seen[t] = true
+			rv = append(rv, t)
+		}
+	}
+	return rv
+}
+
+// SearchArticles runs a full-text search. The hits of the index are read back
+// from the repo, so stale hits of an embedded index are dropped.
+func (uc *SocialUsecase) SearchArticles(ctx context.Context, q SearchQuery) (rv []*SearchResult, count int64, err error) {
+	terms := SearchTerms(q.Text)
+	if len(terms) == 0 {
+		return nil, 0, errors.New(422, "q", "cannot be empty")
+	}
+	if q.Limit <= 0 {
+		q.Limit = DefaultLimit
+	}
+	if q.Author != "" {
+		p, err := uc.pr.GetProfile(ctx, 0, q.Author)
+		if errors.IsNotFound(err) {
+			return []*SearchResult{}, 0, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		q.AuthorID = p.ID
+	}
+	// 私密账号的文章只给作者和关注者看, 计数也不算
+	uid := auth.GetUserIdOrNotLogin(ctx)
+	q.HidePrivate, q.Viewer = true, uid
+	hits, count, err := uc.si.Search(ctx, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(hits) == 0 {
+		return []*SearchResult{}, count, nil
+	}
+	ids := make([]uint, len(hits))
+	for i, h := range hits {
+		ids[i] = h.ArticleID
+	}
+	found, _, err := uc.ar.List(ctx, ArticleQuery{IDs: ids, HidePrivate: true, Viewer: uid})
+	if err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[uint]*Article, len(found))
+	for _, a := range found {
+		byID[a.ID] = a
+	}
+	rv = make([]*SearchResult, 0, len(hits))
+	articles := make([]*Article, 0, len(hits))
+	for _, h := range hits {
+		a, ok := byID[h.ArticleID]
+		if !ok {
+			uc.log.WithContext(ctx).Warnf("search hit %d is stale", h.ArticleID)
+			continue
+		}
+		articles = append(articles, a)
+		rv = append(rv, &SearchResult{
+			Article: a,
+			Score:   h.Score,
+			Highlights: highlights(terms, map[string]string{
+				"title":       a.Title,
+				"description": a.Description,
+				"body":        a.Body,
+			}),
+		})
+	}
+	if uid > 0 {
+		favorited, err := uc.ar.GetFavoritesStatus(ctx, uid, articles)
+		if err != nil {
+			return nil, 0, err
+		}
+		for i, a := range articles {
+			a.Favorited = favorited[i]
+		}
+	}
+	if err := uc.fillAuthors(ctx, articles...); err != nil {
+		return nil, 0, err
+	}
+	return rv, count, nil
+}
+
+// indexArticle updates the search index after a write has committed. The
+// write stands if it fails, the article is only missing from the results.
+func (uc *SocialUsecase) indexArticle(ctx context.Context, a *Article) {
+	if err := uc.si.Index(ctx, a); err != nil {
+		uc.log.WithContext(ctx).Errorf("index article %d: %v", a.ID, err)
+	}
+}
+
+func (uc *SocialUsecase) unindexArticle(ctx context.Context, id uint) {
+	if err := uc.si.Remove(ctx, id); err != nil {
+		uc.log.WithContext(ctx).Errorf("unindex article %d: %v", id, err)
+	}
+}
+
+func highlights(terms []string, fields map[string]string) map[string]string {
+	rv := make(map[string]string)
+	for name, text := range fields {
+		if s, ok := highlight(text, terms); ok {
+			rv[name] = s
+		}
+	}
+	return rv
+}
+
+// highlight returns the fragment of text around the first word that starts
+// with one of terms, with every such word in <mark>, and whether there was one.
+func highlight(text string, terms []string) (string, bool) {
+	first := -1
+	type span struct{ start, end int }
+	var marks []span
+	for _, loc := range wordChars.FindAllStringIndex(text, -1) {
+		word := strings.ToLower(text[loc[0]:loc[1]])
+		for _, t := range terms {
+			if strings.HasPrefix(word, t) {
+				marks = append(marks, span{loc[0], loc[1]})
+				if first < 0 {
+					first = loc[0]
+				}
+				break
+			}
+		}
+	}
+	if first < 0 {
+		return "", false
+	}
+	// 从第一个匹配前面一点开始截取, 按字符而不是字节
+	start := first
+	for n := 0; start > 0 && n < snippetRunes/4; n++ {
+		_, size := utf8.DecodeLastRuneInString(text[:start])
+		start -= size
+	}
+	end := start
+	for n := 0; end < len(text) && n < snippetRunes; n++ {
+		_, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, m := range marks {
+		if m.end <= start || m.start >= end {
+			continue
+		}
+		ms, me := m.start, m.end
+		if ms < start {
+			ms = start
+		}
+		if me > end {
+			me = end
+		}
+		b.WriteString(html.EscapeString(text[pos:ms]))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(text[ms:me]))
+		b.WriteString("</mark>")
+		pos = me
+	}
+	b.WriteString(html.EscapeString(text[pos:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
@@ -0,0 +1,197 @@
+package biz_test
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+	"realworld/internal/data"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestSearchArticles(t *testing.T) {
+	for _, engine := range []string{data.DatabaseSearch, data.BleveSearch} {
+		t.Run(engine, func(t *testing.T) {
+			app := newTestAppWith(t, &conf.Data{Search: &conf.Data_Search{Engine: engine}})
+			alice, bob := app.register(t, "alice"), app.register(t, "bob")
+			sky, err := app.social.CreateArticle(alice, &biz.Article{Title: "Dragons in the sky", Body: "fire", TagList: []string{"myth"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := app.social.CreateArticle(bob, &biz.Article{Title: "Cats", Body: "no <dragons> here"}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := app.social.CreateArticle(bob, &biz.Article{Title: "Secret dragons", Status: biz.StatusDraft}); err != nil {
+				t.Fatal(err)
+			}
+			app.createArticle(t, alice, "Unrelated")
+
+			search := func(q biz.SearchQuery) ([]*biz.SearchResult, []string) {
+				t.Helper()
+				rv, count, err := app.social.SearchArticles(context.Background(), q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if count != int64(len(rv)) {
+					t.Errorf("search %+v: count %d for %d results", q, count, len(rv))
+				}
+				var titles []string
+				for _, r := range rv {
+					titles = append(titles, r.Article.Title)
+				}
+				sort.Strings(titles)
+				return rv, titles
+			}
+			rv, titles := search(biz.SearchQuery{Text: "Dragons"})
+			if strings.Join(titles, ",") != "Cats,Dragons in the sky" {
+				t.Fatalf("search dragons = %q", titles)
+			}
+			for _, r := range rv {
+				switch r.Article.Title {
+				case "Cats":
+					if got := r.Highlights["body"]; got != "no &lt;<mark>dragons</mark>&gt; here" {
+						t.Errorf("body highlight = %q", got)
+					}
+				default:
+					if got := r.Highlights["title"]; got != "<mark>Dragons</mark> in the sky" {
+						t.Errorf("title highlight = %q", got)
+					}
+					if r.Article.Author == nil || r.Article.Author.Username != "alice" {
+						t.Errorf("author = %+v", r.Article.Author)
+					}
+				}
+			}
+			if _, titles = search(biz.SearchQuery{Text: "dragons", ArticleQuery: biz.ArticleQuery{Author: "bob"}}); strings.Join(titles, ",") != "Cats" {
+				t.Errorf("search dragons by bob = %q", titles)
+			}
+			if _, titles = search(biz.SearchQuery{Text: "dragons", ArticleQuery: biz.ArticleQuery{Tags: []string{"myth"}}}); strings.Join(titles, ",") != "Dragons in the sky" {
+				t.Errorf("search dragons tagged myth = %q", titles)
+			}
+			if _, titles = search(biz.SearchQuery{Text: "dragons", ArticleQuery: biz.ArticleQuery{Author: "nobody"}}); len(titles) != 0 {
+				t.Errorf("search dragons by nobody = %q", titles)
+			}
+			if _, _, err := app.social.SearchArticles(context.Background(), biz.SearchQuery{Text: " ?! "}); errors.Code(err) != 422 {
+				t.Errorf("search without words: %v, want 422", err)
+			}
+
+			// 索引随文章更新和删除
+			if _, err := app.social.UpdateArticle(alice, &biz.Article{Slug: sky.Slug, Title: "Wyverns in the sky", TagList: []string{"myth"}}); err != nil {
+				t.Fatal(err)
+			}
+			if _, titles = search(biz.SearchQuery{Text: "wyverns"}); strings.Join(titles, ",") != "Wyverns in the sky" {
+				t.Errorf("search after update = %q", titles)
+			}
+			if err := app.social.DeleteArticle(alice, "wyverns-in-the-sky"); err != nil {
+				t.Fatal(err)
+			}
+			if _, titles = search(biz.SearchQuery{Text: "wyverns"}); len(titles) != 0 {
+				t.Errorf("search after delete = %q", titles)
+			}
+		})
+	}
+}
+
+func TestSearchHidesPrivateArticles(t *testing.T) {
+	for _, engine := range []string{data.DatabaseSearch, data.BleveSearch} {
+		t.Run(engine, func(t *testing.T) {
+			app := newTestAppWith(t, &conf.Data{Search: &conf.Data_Search{Engine: engine}})
+			alice, bob := app.register(t, "alice"), app.register(t, "bob")
+			app.createArticle(t, alice, "Dragons of alice")
+			app.createArticle(t, bob, "Dragons of bob")
+			private := true
+			if _, err := app.users.UpdateUser(alice, uid(alice), &biz.UpdateUser{Private: &private}); err != nil {
+				t.Fatal(err)
+			}
+
+			search := func(ctx context.Context) ([]string, int64) {
+				t.Helper()
+				rv, count, err := app.social.SearchArticles(ctx, biz.SearchQuery{Text: "dragons"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				var titles []string
+				for _, r := range rv {
+					titles = append(titles, r.Article.Title)
+				}
+				return titles, count
+			}
+			if titles, count := search(context.Background()); count != 1 || len(titles) != 1 || titles[0] != "Dragons of bob" {
+				t.Errorf("anonymous search = %q (%d)", titles, count)
+			}
+			if titles, count := search(bob); count != 1 || len(titles) != 1 {
+				t.Errorf("bob searches %q (%d)", titles, count)
+			}
+			if titles, count := search(alice); count != 2 || len(titles) != 2 {
+				t.Errorf("alice searches %q (%d)", titles, count)
+			}
+		})
+	}
+}
+
+func TestSearchFilters(t *testing.T) {
+	for _, engine := range []string{data.DatabaseSearch, data.BleveSearch} {
+		t.Run(engine, func(t *testing.T) {
+			app := newTestAppWith(t, &conf.Data{Search: &conf.Data_Search{Engine: engine}})
+			alice, bob := app.register(t, "alice"), app.register(t, "bob")
+			red := app.createArticle(t, alice, "Red dragons", "dragons", "red")
+			app.createArticle(t, alice, "Green dragons", "dragons", "green")
+			old := app.createArticle(t, alice, "Dragons of old", "myth")
+			if _, err := app.social.FavoriteArticle(bob, red.Slug); err != nil {
+				t.Fatal(err)
+			}
+			s, err := app.social.CreateSeries(alice, "parts", []string{old.Slug, red.Slug})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			search := func(ctx context.Context, q biz.ArticleQuery) string {
+				t.Helper()
+				rv, count, err := app.social.SearchArticles(ctx, biz.SearchQuery{Text: "dragons", ArticleQuery: q})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if count != int64(len(rv)) {
+					t.Errorf("search %+v: count %d for %d results", q, count, len(rv))
+				}
+				var titles []string
+				for _, r := range rv {
+					titles = append(titles, r.Article.Title)
+				}
+				sort.Strings(titles)
+				return strings.Join(titles, ",")
+			}
+			for _, c := range []struct {
+				q    biz.ArticleQuery
+				want string
+			}{
+				{biz.ArticleQuery{Tags: []string{"red", "myth"}}, "Dragons of old,Red dragons"},
+				{biz.ArticleQuery{Tags: []string{"dragons", "red"}, AllTags: true}, "Red dragons"},
+				{biz.ArticleQuery{ExcludeTags: []string{"green"}}, "Dragons of old,Red dragons"},
+				{biz.ArticleQuery{FavoritedBy: "bob"}, "Red dragons"},
+				{biz.ArticleQuery{FavoritedBy: "nobody"}, ""},
+				{biz.ArticleQuery{SeriesID: s.ID, ExcludeTags: []string{"myth"}}, "Red dragons"},
+				{biz.ArticleQuery{CreatedAfter: time.Now().Add(-time.Hour)}, "Dragons of old,Green dragons,Red dragons"},
+				{biz.ArticleQuery{CreatedBefore: time.Now().Add(-time.Hour)}, ""},
+			} {
+				if got := search(context.Background(), c.q); got != c.want {
+					t.Errorf("search %+v = %q, want %q", c.q, got, c.want)
+				}
+			}
+
+			rv, _, err := app.social.SearchArticles(bob, biz.SearchQuery{Text: "dragons"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range rv {
+				if want := r.Article.ID == red.ID; r.Article.Favorited != want {
+					t.Errorf("%q favorited by bob = %v, want %v", r.Article.Title, r.Article.Favorited, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/pkg/middleware/auth"
+)
+
+// ErrSeriesNotFound is returned for series that don't exist.
+var ErrSeriesNotFound = errors.NotFound("series", "series not found")
+
+// Series is an ordered list of articles of one author, the parts of a
+// tutorial for example. An article is in one series at most.
+type Series struct {
+	ID        uint
+	Title     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	AuthorID uint
+	Author   *Profile
+	// Articles are the parts the current user may see, in order.
+	Articles []*Article
+}
+
+// ArticleSeries is where an article is in its series, counting the parts the
+// current user may see. Prev and Next are the slugs of the parts around it,
+// empty at the ends.
+type ArticleSeries struct {
+	ID    uint
+	Title string
+	// Position starts at 1.
+	Position int
+	Count    int
+	Prev     string
+	Next     string
+}
+
+type SeriesRepo interface {
+	Create(ctx context.Context, s *Series) (*Series, error)
+	Get(ctx context.Context, id uint) (*Series, error)
+	Rename(ctx context.Context, id uint, title string) error
+	// Delete removes the series, its articles stay.
+	Delete(ctx context.Context, id uint) error
+	// Articles returns the articles of a series in order, whatever their
+	// status.
+	Articles(ctx context.Context, id uint) ([]*Article, error)
+	// SetArticles makes articleIDs, in this order, the articles of a series.
+	SetArticles(ctx context.Context, id uint, articleIDs []uint) error
+	// OfArticle returns the id of the series an article is in, 0 if none.
+	OfArticle(ctx context.Context, articleID uint) (uint, error)
+}
+
+// seriesOfAuthor returns the series with id if the current user owns it.
+func (uc *SocialUsecase) seriesOfAuthor(ctx context.Context, id uint) (*Series, error) {
+	s, err := uc.sr.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s.AuthorID != auth.FromContext(ctx).UserID {
+		return nil, errors.Unauthorized("user", "verifyAuthor fail")
+	}
+	return s, nil
+}
+
+func seriesTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", errors.New(422, "title", "can't be blank")
+	}
+	return title, nil
+}
+
+// seriesArticles returns the ids of the articles with slugs, which must be
+// articles of the current user that are in no other series than id, 0 for a
+// new series.
+func (uc *SocialUsecase) seriesArticles(ctx context.Context, id uint, slugs []string) ([]uint, error) {
+	ids := make([]uint, 0, len(slugs))
+	seen := make(map[uint]bool)
+	for _, slug := range slugs {
+		a, err := uc.articleOfAuthor(ctx, slug)
+		if err != nil {
+			return nil, err
+		}
+		if seen[a.ID] {
+			return nil, errors.New(422, "articles", slug+" is listed twice")
+		}
+		seen[a.ID] = true
+		sid, err := uc.sr.OfArticle(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+		if sid != 0 && sid != id {
+			return nil, errors.New(422, "articles", slug+" is in another series")
+		}
+		ids = append(ids, a.ID)
+	}
+	return ids, nil
+}
+
+// CreateSeries creates a series of the current user with the articles with
+// slugs, in this order.
+func (uc *SocialUsecase) CreateSeries(ctx context.Context, title string, slugs []string) (rv *Series, err error) {
+	title, err = seriesTitle(title)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		ids, err := uc.seriesArticles(ctx, 0, slugs)
+		if err != nil {
+			return err
+		}
+		rv, err = uc.sr.Create(ctx, &Series{Title: title, AuthorID: auth.FromContext(ctx).UserID})
+		if err != nil {
+			return err
+		}
+		return uc.sr.SetArticles(ctx, rv.ID, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.GetSeries(ctx, rv.ID)
+}
+
+// GetSeries returns the series with the articles the current user may see.
+func (uc *SocialUsecase) GetSeries(ctx context.Context, id uint) (*Series, error) {
+	rv, err := uc.sr.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	articles, err := uc.visibleSeriesArticles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if uid := auth.GetUserIdOrNotLogin(ctx); uid > 0 {
+		for _, a := range articles {
+			a.Favorited = uc.ar.CheckFavorited(ctx, uid, a.ID)
+		}
+	}
+	if err := uc.fillAuthors(ctx, articles...); err != nil {
+		return nil, err
+	}
+	authors, err := uc.profileLoader(ctx).Load(ctx, rv.AuthorID)
+	if err != nil {
+		return nil, err
+	}
+	rv.Author = authors[rv.AuthorID]
+	if rv.Author == nil {
+		rv.Author = &Profile{ID: rv.AuthorID}
+	}
+	rv.Articles = articles
+	return rv, nil
+}
+
+// visibleSeriesArticles returns the articles of series id the current user
+// may see, drafts and articles of private owners left out.
+func (uc *SocialUsecase) visibleSeriesArticles(ctx context.Context, id uint) ([]*Article, error) {
+	articles, err := uc.sr.Articles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	uid := auth.GetUserIdOrNotLogin(ctx)
+	rv := articles[:0]
+	for _, a := range articles {
+		if !a.visibleTo(uid) {
+			continue
+		}
+		ok, err := uc.seesArticle(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rv = append(rv, a)
+		}
+	}
+	return rv, nil
+}
+
+func (uc *SocialUsecase) RenameSeries(ctx context.Context, id uint, title string) (*Series, error) {
+	title, err := seriesTitle(title)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.seriesOfAuthor(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := uc.sr.Rename(ctx, id, title); err != nil {
+		return nil, err
+	}
+	return uc.GetSeries(ctx, id)
+}
+
+// SetSeriesArticles makes the articles with slugs, in this order, the
+// articles of the series: it adds, reorders and removes them at once.
+func (uc *SocialUsecase) SetSeriesArticles(ctx context.Context, id uint, slugs []string) (*Series, error) {
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.seriesOfAuthor(ctx, id); err != nil {
+			return err
+		}
+		ids, err := uc.seriesArticles(ctx, id, slugs)
+		if err != nil {
+			return err
+		}
+		return uc.sr.SetArticles(ctx, id, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.GetSeries(ctx, id)
+}
+
+func (uc *SocialUsecase) DeleteSeries(ctx context.Context, id uint) error {
+	return uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if _, err := uc.seriesOfAuthor(ctx, id); err != nil {
+			return err
+		}
+		return uc.sr.Delete(ctx, id)
+	})
+}
+
+// articleSeries returns where a is in its series, nil if it is in none.
+func (uc *SocialUsecase) articleSeries(ctx context.Context, a *Article) (*ArticleSeries, error) {
+	id, err := uc.sr.OfArticle(ctx, a.ID)
+	if err != nil || id == 0 {
+		return nil, err
+	}
+	s, err := uc.sr.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	articles, err := uc.visibleSeriesArticles(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rv := &ArticleSeries{ID: s.ID, Title: s.Title, Count: len(articles)}
+	for i, x := range articles {
+		if x.ID != a.ID {
+			continue
+		}
+		rv.Position = i + 1
+		if i > 0 {
+			rv.Prev = articles[i-1].Slug
+		}
+		if i+1 < len(articles) {
+			rv.Next = articles[i+1].Slug
+		}
+	}
+	return rv, nil
+}
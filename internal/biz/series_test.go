@@ -0,0 +1,127 @@
+package biz_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestSeries(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	a1, a2 := app.createArticle(t, alice, "part one"), app.createArticle(t, alice, "part two")
+	draft, err := app.social.CreateArticle(alice, &biz.Article{Title: "part three", Status: biz.StatusDraft})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := app.social.CreateSeries(alice, " parts ", []string{a1.Slug, draft.Slug, a2.Slug})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Title != "parts" || s.Author == nil || s.Author.Username != "alice" {
+		t.Errorf("series %q by %+v", s.Title, s.Author)
+	}
+	if want := []string{a1.Slug, draft.Slug, a2.Slug}; !reflect.DeepEqual(slugs(s.Articles), want) {
+		t.Errorf("series of alice = %q, want %q", slugs(s.Articles), want)
+	}
+
+	// 别人看不到草稿, 前后篇也跳过它
+	rv, err := app.social.GetSeries(context.Background(), s.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{a1.Slug, a2.Slug}; !reflect.DeepEqual(slugs(rv.Articles), want) {
+		t.Errorf("anonymous series = %q, want %q", slugs(rv.Articles), want)
+	}
+	a, err := app.social.GetArticle(bob, a2.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&biz.ArticleSeries{ID: s.ID, Title: "parts", Position: 2, Count: 2, Prev: a1.Slug}); !reflect.DeepEqual(a.Series, want) {
+		t.Errorf("series of %s = %+v, want %+v", a2.Slug, a.Series, want)
+	}
+
+	if rv, err = app.social.SetSeriesArticles(alice, s.ID, []string{a2.Slug, a1.Slug}); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{a2.Slug, a1.Slug}; !reflect.DeepEqual(slugs(rv.Articles), want) {
+		t.Errorf("reordered series = %q, want %q", slugs(rv.Articles), want)
+	}
+	if a, err = app.social.GetArticle(alice, draft.Slug); err != nil || a.Series != nil {
+		t.Errorf("series of the removed article = %+v, %v", a.Series, err)
+	}
+
+	for name, err := range map[string]error{
+		"listed twice":   second(app.social.SetSeriesArticles(alice, s.ID, []string{a1.Slug, a1.Slug})),
+		"another series": second(app.social.CreateSeries(alice, "again", []string{a1.Slug})),
+		"blank title":    second(app.social.RenameSeries(alice, s.ID, " ")),
+	} {
+		if errors.Code(err) != 422 {
+			t.Errorf("%s: %v, want 422", name, err)
+		}
+	}
+	for name, err := range map[string]error{
+		"rename":          second(app.social.RenameSeries(bob, s.ID, "mine")),
+		"set articles":    second(app.social.SetSeriesArticles(bob, s.ID, nil)),
+		"delete":          app.social.DeleteSeries(bob, s.ID),
+		"others articles": second(app.social.CreateSeries(bob, "stolen", []string{draft.Slug})),
+	} {
+		if !errors.IsUnauthorized(err) && !errors.IsNotFound(err) {
+			t.Errorf("%s by bob: %v, want refused", name, err)
+		}
+	}
+
+	if err := app.social.DeleteSeries(alice, s.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := app.social.GetSeries(alice, s.ID); !errors.IsNotFound(err) {
+		t.Errorf("deleted series: %v, want not found", err)
+	}
+	if a, err = app.social.GetArticle(alice, a1.Slug); err != nil || a.Series != nil {
+		t.Errorf("article of a deleted series = %+v, %v", a, err)
+	}
+}
+
+func second[T any](_ T, err error) error {
+	return err
+}
+
+func TestSeriesHidesPrivateArticles(t *testing.T) {
+	app := newTestApp(t)
+	alice := app.register(t, "alice")
+	a1, a2 := app.createArticle(t, alice, "part one"), app.createArticle(t, alice, "part two")
+	s, err := app.social.CreateSeries(alice, "parts", []string{a1.Slug, a2.Slug})
+	if err != nil {
+		t.Fatal(err)
+	}
+	private := true
+	if _, err := app.users.UpdateUser(alice, uid(alice), &biz.UpdateUser{Private: &private}); err != nil {
+		t.Fatal(err)
+	}
+
+	rv, err := app.social.GetSeries(context.Background(), s.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rv.Articles) != 0 {
+		t.Errorf("anonymous series articles = %q", slugs(rv.Articles))
+	}
+	rv, err = app.social.GetSeries(alice, s.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rv.Articles) != 2 {
+		t.Errorf("alice series articles = %q", slugs(rv.Articles))
+	}
+	a, err := app.social.GetArticle(alice, a2.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Series == nil || a.Series.Prev != a1.Slug || a.Series.Count != 2 {
+		t.Errorf("alice series of %s = %+v", a2.Slug, a.Series)
+	}
+}
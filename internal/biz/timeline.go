@@ -0,0 +1,55 @@
+package biz
+
+import (
+	"context"
+)
+
+// TimelineRepo keeps the feed of every user materialized: published articles
+// are pushed to the timelines of the followers of their author when they are
+// written, instead of being looked up through follows when the feed is read.
+//
+// Articles of authors with more followers than the fan-out limit are not
+// pushed. They stay unmarked and the feed reads them through follows, as it
+// does for articles published before timelines existed.
+type TimelineRepo interface {
+	// Push adds a published article to the timelines of its author's
+	// followers and marks it pushed, unless it already is or the author has
+	// too many followers.
+	Push(ctx context.Context, articleID, authorID uint) error
+	// RemoveArticle takes an article out of every timeline and unmarks it.
+	RemoveArticle(ctx context.Context, articleID uint) error
+	// Backfill adds the latest pushed articles of an author, as many as a
+	// timeline holds, to the timeline of a new follower.
+	Backfill(ctx context.Context, userID, authorID uint) error
+	// RemoveAuthor takes the articles of an author out of a timeline.
+	RemoveAuthor(ctx context.Context, userID, authorID uint) error
+	// Trim drops the oldest articles of the timelines that hold more than
+	// their size and returns how many were dropped.
+	Trim(ctx context.Context) (int64, error)
+}
+
+// pushArticle updates the timelines after an article was written: published
+// articles are pushed and the others taken out. Like indexArticle it runs
+// after the write has committed, which stands if it fails.
+func (uc *SocialUsecase) pushArticle(ctx context.Context, a *Article) {
+	var err error
+	if a.Status == StatusPublished {
+		err = uc.tl.Push(ctx, a.ID, a.AuthorUserID)
+	} else {
+		err = uc.tl.RemoveArticle(ctx, a.ID)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("push article %d to timelines: %v", a.ID, err)
+	}
+}
+
+func (uc *SocialUsecase) unpushArticle(ctx context.Context, id uint) {
+	if err := uc.tl.RemoveArticle(ctx, id); err != nil {
+		uc.log.WithContext(ctx).Errorf("remove article %d from timelines: %v", id, err)
+	}
+}
+
+// TrimTimelines keeps the timelines within their size.
+func (uc *SocialUsecase) TrimTimelines(ctx context.Context) (int64, error) {
+	return uc.tl.Trim(ctx)
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/pkg/middleware/auth"
+)
+
+// ErrCommentNotFound is returned for comments that don't exist, or are not in
+// the trash when restoring.
+var ErrCommentNotFound = errors.NotFound("comment", "comment not found")
+
+// TrashRepo reaches the deleted articles and comments, which stay in the
+// trash until they are purged. A deleted article keeps its slug and title, so
+// no other article can take them while it is in the trash.
+type TrashRepo interface {
+	// Articles returns the deleted articles of an owner, the latest deleted
+	// first.
+	Articles(ctx context.Context, authorID uint) ([]*Article, error)
+	// Comments returns the deleted comments of an author on articles that are
+	// not deleted, the latest deleted first.
+	Comments(ctx context.Context, authorID uint) ([]*Comment, error)
+	// Article returns a deleted article, or ErrArticleNotFound.
+	Article(ctx context.Context, id uint) (*Article, error)
+	// Comment returns a deleted comment, or ErrCommentNotFound.
+	Comment(ctx context.Context, id uint) (*Comment, error)
+	RestoreArticle(ctx context.Context, id uint) error
+	RestoreComment(ctx context.Context, id uint) error
+	// Purge deletes for good the articles and comments deleted before before,
+	// and everything of those articles. The slugs of the articles stay taken.
+	Purge(ctx context.Context, before time.Time) (articles, comments int64, err error)
+}
+
+// Trash is what the current user deleted and may restore.
+type Trash struct {
+	Articles []*Article
+	Comments []*Comment
+}
+
+// ListTrash returns the deleted articles the current user owns and the
+// comments they deleted.
+func (uc *SocialUsecase) ListTrash(ctx context.Context) (*Trash, error) {
+	uid := auth.FromContext(ctx).UserID
+	articles, err := uc.tr.Articles(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := uc.tr.Comments(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillAuthors(ctx, articles...); err != nil {
+		return nil, err
+	}
+	for _, c := range comments {
+		if c.Article, err = uc.ar.GetArticle(ctx, c.ArticleID); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.fillCommentAuthors(ctx, comments...); err != nil {
+		return nil, err
+	}
+	return &Trash{Articles: articles, Comments: comments}, nil
+}
+
+// RestoreArticle takes an article of the current user out of the trash, as it
+// was when deleted.
+func (uc *SocialUsecase) RestoreArticle(ctx context.Context, slug string) (rv *Article, err error) {
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		id, err := uc.ar.SlugOwner(ctx, slug)
+		if err != nil {
+			return err
+		}
+		a, err := uc.tr.Article(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !a.verifyOwner(auth.FromContext(ctx).UserID) {
+			return errors.Unauthorized("user", "verifyOwner fail")
+		}
+		// 回收站里的文章仍占着 slug 和标题, 这里只是防止规则被绕过
+		if owner, err := uc.ar.SlugOwner(ctx, a.Slug); err != nil {
+			return err
+		} else if owner != a.ID {
+			return errors.Conflict("article", "slug is taken by another article")
+		}
+		if err := uc.checkTitle(ctx, a.Title, a.ID); err != nil {
+			return err
+		}
+		if err := uc.tr.RestoreArticle(ctx, a.ID); err != nil {
+			return err
+		}
+		rv, err = uc.ar.GetArticle(ctx, a.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.indexArticle(ctx, rv)
+	uc.pushArticle(ctx, rv)
+	if rv.Status == StatusPublished {
+		uc.recountArticles(ctx, rv.AuthorUserID)
+	}
+	if rv.Series, err = uc.articleSeries(ctx, rv); err != nil {
+		return nil, err
+	}
+	if err := uc.fillAuthors(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// RestoreComment takes a comment of the current user out of the trash. Its
+// article must not be deleted.
+func (uc *SocialUsecase) RestoreComment(ctx context.Context, slug string, id uint) (rv *Comment, err error) {
+	err = uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		a, err := uc.visibleArticle(ctx, slug)
+		if err != nil {
+			return err
+		}
+		c, err := uc.tr.Comment(ctx, id)
+		if err != nil {
+			return err
+		}
+		if c.ArticleID != a.ID {
+			return ErrCommentNotFound
+		}
+		if !c.verifyAuthor(auth.FromContext(ctx).UserID) {
+			return errors.Unauthorized("user", "verifyAuthor fail")
+		}
+		if err := uc.tr.RestoreComment(ctx, id); err != nil {
+			return err
+		}
+		rv, err = uc.cr.Get(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.fillCommentAuthors(ctx, rv); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// PurgeTrash deletes for good what was deleted before before.
+func (uc *SocialUsecase) PurgeTrash(ctx context.Context, before time.Time) (articles, comments int64, err error) {
+	return uc.tr.Purge(ctx, before)
+}
@@ -0,0 +1,78 @@
+package biz_test
+
+import (
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/internal/biz"
+)
+
+func TestTrash(t *testing.T) {
+	app := newTestApp(t)
+	alice, bob := app.register(t, "alice"), app.register(t, "bob")
+	a := app.createArticle(t, alice, "Hello")
+	c, err := app.social.AddComment(bob, a.Slug, &biz.Comment{Body: "nice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := app.social.DeleteArticle(bob, a.Slug); !errors.IsUnauthorized(err) {
+		t.Errorf("delete by bob: %v", err)
+	}
+	if err := app.social.DeleteArticle(alice, a.Slug); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := app.social.GetArticle(alice, a.Slug); !errors.IsNotFound(err) {
+		t.Errorf("get a deleted article: %v", err)
+	}
+	// 回收站里的文章仍占着标题
+	if _, err := app.social.CreateArticle(alice, &biz.Article{Title: "Hello", Description: "d", Body: "b"}); err == nil {
+		t.Error("created an article with the title of a deleted one")
+	}
+	trash, err := app.social.ListTrash(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := slugs(trash.Articles); len(got) != 1 || got[0] != a.Slug {
+		t.Errorf("alice's trash = %q", got)
+	}
+	if trash, err := app.social.ListTrash(bob); err != nil || len(trash.Articles) != 0 || len(trash.Comments) != 0 {
+		t.Errorf("bob's trash = %+v, %v", trash, err)
+	}
+	if _, err := app.social.RestoreArticle(bob, a.Slug); !errors.IsUnauthorized(err) {
+		t.Errorf("restore by bob: %v", err)
+	}
+	rv, err := app.social.RestoreArticle(alice, a.Slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rv.ID != a.ID || rv.Slug != a.Slug || rv.Author == nil || rv.Author.Username != "alice" {
+		t.Errorf("restored %+v", rv)
+	}
+	if _, err := app.social.RestoreArticle(alice, a.Slug); !errors.IsNotFound(err) {
+		t.Errorf("restore twice: %v", err)
+	}
+	if cs, _, err := app.social.ListComments(alice, a.Slug, "", 0); err != nil || len(cs) != 1 {
+		t.Errorf("comments of the restored article = %d, %v", len(cs), err)
+	}
+
+	if err := app.social.DeleteComment(bob, c.ID); err != nil {
+		t.Fatal(err)
+	}
+	trash, err = app.social.ListTrash(bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trash.Comments) != 1 || trash.Comments[0].ID != c.ID || trash.Comments[0].Article.ID != a.ID {
+		t.Errorf("bob's trashed comments = %+v", trash.Comments)
+	}
+	if _, err := app.social.RestoreComment(alice, a.Slug, c.ID); !errors.IsUnauthorized(err) {
+		t.Errorf("restore bob's comment by alice: %v", err)
+	}
+	if rv, err := app.social.RestoreComment(bob, a.Slug, c.ID); err != nil || rv.Author == nil || rv.Author.Username != "bob" {
+		t.Errorf("restore comment = %+v, %v", rv, err)
+	}
+	if cs, _, err := app.social.ListComments(alice, a.Slug, "", 0); err != nil || len(cs) != 1 {
+		t.Errorf("comments after restore = %d, %v", len(cs), err)
+	}
+}
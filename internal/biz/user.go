@@ -46,6 +46,7 @@ type User struct {
 	Bio          string `json:"bio"`
 	Image        string `json:"image"`
 	PasswordHash string `json:"passwordHash"`
+	Private      bool   `json:"private"`
 }
 
 type UserLogin struct {
@@ -71,6 +72,8 @@ type UpdateUser struct {
 	Image        string `json:"image"`
 	Password     string `json:"password"`
 	PasswordHash string `json:"passwordHash"`
+	// Private is left as is when nil.
+	Private *bool `json:"private"`
 }
 
 // userRepo is a Greater repo.
@@ -89,13 +92,14 @@ type UserRepo interface {
 // GreeterUsecase is a Greeter usecase.
 type UserUsecase struct {
 	repo UserRepo
+	tx   Transaction
 	log  *log.Helper
 	jwtc *conf.JWT
 }
 
 // NewGreeterUsecase new a Greeter usecase.
-func NewUserUsecase(repo UserRepo, logger log.Logger, jwtc *conf.JWT) *UserUsecase {
-	return &UserUsecase{repo: repo, log: log.NewHelper(logger), jwtc: jwtc}
+func NewUserUsecase(repo UserRepo, tx Transaction, logger log.Logger, jwtc *conf.JWT) *UserUsecase {
+	return &UserUsecase{repo: repo, tx: tx, log: log.NewHelper(logger), jwtc: jwtc}
 }
 
 // CreateGreeter creates a Greeter, and returns the new Greeter.
@@ -140,12 +144,14 @@ func (uc *UserUsecase) Registration(ctx context.Context, email, password string,
 		Image:        "http://img.wxcha.com/m00/f0/f5/5e3999ad5a8d62188ac5ba8ca32e058f.jpg",
 	}
 
-	// 检查用户名
-	if _, err := uc.repo.GetUserByEmail(ctx, email); err == nil {
-		return nil, errors.New(400, "USER_EXITS", "user exits")
-	}
-
-	if err := uc.repo.CreateUser(ctx, u); err != nil {
+	err := uc.tx.ExecTx(ctx, func(ctx context.Context) error {
+		// 检查用户名
+		if _, err := uc.repo.GetUserByEmail(ctx, email); err == nil {
+			return errors.New(400, "USER_EXITS", "user exits")
+		}
+		return uc.repo.CreateUser(ctx, u)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &UserRegistration{
@@ -164,6 +170,7 @@ func (uc *UserUsecase) GetCurrentUser(ctx context.Context, id uint) (*User, erro
 		Username: u.Username,
 		Bio:      u.Bio,
 		Image:    u.Image,
+		Private:  u.Private,
 		Token:    uc.generateToken(id),
 	}, nil
 }
@@ -175,11 +182,16 @@ func (uc *UserUsecase) UpdateUser(ctx context.Context, id uint, uu *UpdateUser)
 	if err := uc.repo.UpdateUser(ctx, id, uu); err != nil {
 		return nil, err
 	}
+	u, err := uc.repo.GetUserById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
 	return &User{
 		Email:    uu.Email,
 		Username: uu.Username,
 		Image:    uu.Image,
 		Bio:      uu.Bio,
+		Private:  u.Private,
 		Token:    uc.generateToken(id),
 	}, nil
 }
@@ -2,25 +2,62 @@ package data
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"gorm.io/plugin/dbresolver"
 	"realworld/internal/biz"
+	"time"
 )
 
 type Article struct {
 	gorm.Model
-	Slug           string `gorm:"size:200"`
-	Title          string `gorm:"size:200;unique"`
-	Description    string `gorm:"size:200"`
-	Body           string
-	Tags           []Tag `gorm:"many2many:article_tags;"`
-	AuthorID       uint
+	Slug        string `gorm:"size:200;uniqueIndex"`
+	Title       string `gorm:"size:200;unique"`
+	Description string `gorm:"size:200"`
+	Body        string
+	Tags        []Tag `gorm:"many2many:article_tags;"`
+	AuthorID    uint  `gorm:"index:idx_articles_fanned_out_author_id,priority:2"`
+	// CoAuthors are the co-authors who accepted, see preloadCoAuthors.
+	CoAuthors      []ArticleAuthor
 	FavoritesCount uint32
-	Username       string `gorm:"size:200"`
-	Email          string `gorm:"size:200"`
-	Image          string `gorm:"size:200"`
+	Status         string     `gorm:"size:20;index:idx_articles_status_publish_at"`
+	PublishAt      *time.Time `gorm:"index:idx_articles_status_publish_at"`
+	// BodyHTML is nil for articles saved before bodies were rendered.
+	BodyHTML    *string
+	TOC         []ArticleHeading `gorm:"serializer:json"`
+	WordCount   uint32
+	ReadingTime uint32
+	// FannedOut is set while the article is in the timelines of the
+	// followers of its author, see biz.TimelineRepo.
+	FannedOut bool `gorm:"index:idx_articles_fanned_out_author_id,priority:1"`
+}
+
+// ArticleHeading is biz.Heading as stored in the toc of an article.
+type ArticleHeading struct {
+	Level int    `json:"level"`
+	ID    string `json:"id"`
+	Text  string `json:"text"`
+}
+
+// ArticleAuthor is a co-author of an article, or an invitation to become one
+// while AcceptedAt is nil. The owner is Article.AuthorID.
+type ArticleAuthor struct {
+	ID         uint `gorm:"primarykey"`
+	CreatedAt  time.Time
+	ArticleID  uint `gorm:"uniqueIndex:idx_article_authors_article_user"`
+	UserID     uint `gorm:"uniqueIndex:idx_article_authors_article_user;index"`
+	InvitedBy  uint
+	AcceptedAt *time.Time
+}
+
+// preloadCoAuthors loads the CoAuthors of the articles found, in the order
+// they joined.
+func preloadCoAuthors(db *gorm.DB) *gorm.DB {
+	return db.Preload("CoAuthors", func(db *gorm.DB) *gorm.DB {
+		return db.Where("accepted_at IS NOT NULL").Order("accepted_at, id")
+	})
 }
 
 type Tag struct {
@@ -29,6 +66,15 @@ type Tag struct {
 	Articles []Article `gorm:"many2many:article_tags;"`
 }
 
+// ArticleSlug is a slug an article had before its title changed, so that old
+// links keep resolving to it.
+type ArticleSlug struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	ArticleID uint   `gorm:"index"`
+	Slug      string `gorm:"size:200;uniqueIndex"`
+}
+
 type ArticleFavorite struct {
 	gorm.Model
 	UserID    uint
@@ -46,67 +92,112 @@ func convertArticle(x Article) *biz.Article {
 	for _, t := range x.Tags {
 		tag = append(tag, t.Name)
 	}
+	coAuthors := make([]uint, len(x.CoAuthors))
+	for i, c := range x.CoAuthors {
+		coAuthors[i] = c.UserID
+	}
+	var publishAt time.Time
+	if x.PublishAt != nil {
+		publishAt = *x.PublishAt
+	}
 	return &biz.Article{
 		ID:             x.ID,
 		Slug:           x.Slug,
 		Title:          x.Title,
 		Description:    x.Description,
 		AuthorUserID:   x.AuthorID,
+		CoAuthorIDs:    coAuthors,
 		Body:           x.Body,
 		CreatedAt:      x.CreatedAt,
 		UpdatedAt:      x.UpdatedAt,
+		DeletedAt:      x.DeletedAt.Time,
 		FavoritesCount: x.FavoritesCount,
 		TagList:        tag,
-		Author: &biz.Profile{
-			Username: x.Username,
-			Email:    x.Email,
-			Image:    x.Image,
-		},
+		Status:         biz.ArticleStatus(x.Status),
+		PublishAt:      publishAt,
+		Rendering:      rendering(x),
+		Author:         &biz.Profile{ID: x.AuthorID},
+	}
+}
+
+// rendering returns the stored rendering of x, and renders the articles saved
+// before there was one.
+func rendering(x Article) *biz.Rendering {
+	if x.BodyHTML == nil {
+		r, err := biz.RenderMarkdown(x.Body)
+		if err != nil {
+			return nil
+		}
+		return r
+	}
+	toc := make([]biz.Heading, len(x.TOC))
+	for i, h := range x.TOC {
+		toc[i] = biz.Heading{Level: h.Level, ID: h.ID, Text: h.Text}
 	}
+	return &biz.Rendering{HTML: *x.BodyHTML, TOC: toc, WordCount: x.WordCount, ReadingTime: x.ReadingTime}
+}
+
+// setRendering stores r in x, if the body was rendered.
+func (x *Article) setRendering(r *biz.Rendering) {
+	if r == nil {
+		return
+	}
+	x.BodyHTML = &r.HTML
+	x.TOC = make([]ArticleHeading, len(r.TOC))
+	for i, h := range r.TOC {
+		x.TOC[i] = ArticleHeading{Level: h.Level, ID: h.ID, Text: h.Text}
+	}
+	x.WordCount, x.ReadingTime = r.WordCount, r.ReadingTime
 }
 
 func NewArticleRepo(data *Data, logger log.Logger) biz.ArticleRepo {
+	if data.mem != nil {
+		return &memArticleRepo{mem: data.mem}
+	}
 	return &articleRepo{
 		data: data,
 		log:  log.NewHelper(logger),
 	}
 }
 
-func (r *articleRepo) List(ctx context.Context, los biz.ListOptions, opts ...biz.DbOption) (rv []*biz.Article, count int64, err error) {
-	var articles []Article
-	user := &User{}
-	tag := &Tag{}
-	if len(los.Favorited) > 0 {
-		err := r.data.db.Model(&User{}).Where("username", los.Favorited).First(&user).Error
-		if err != nil {
-			return nil, 0, err
-		}
-	}
-	if len(los.Tag) > 0 {
-		err := r.data.db.Model(&Tag{}).Where("name", los.Tag).First(&tag).Error
-		if err != nil {
+func (r *articleRepo) List(ctx context.Context, q biz.ArticleQuery) (rv []*biz.Article, count int64, err error) {
+	// 计数和查询共用同样的条件
+	db := filterArticles(ctx, r.data, q).Session(&gorm.Session{})
+	// 按游标翻页时不再计数
+	if q.AfterID == 0 {
+		if err := db.Count(&count).Error; err != nil {
 			return nil, 0, err
 		}
 	}
 
-	db := r.data.db
-	db2 := r.data.db
-	for _, opt := range opts {
-		db = db.Scopes(opt)
+	key := articleSortKey(q)
+	asc := q.Ascending || q.SeriesID > 0
+	if q.AfterID > 0 {
+		op := "<"
+		if asc {
+			op = ">"
+		}
+		// 和游标里的排序值比较, 游标的文章改了或删了也能接着翻
+		var after interface{} = q.AfterKey
+		if !q.KeyedSort() {
+			after = q.AfterUpdated
+		}
+		if key == "" {
+			db = db.Where("articles.id "+op+" ?", q.AfterID)
+		} else {
+			db = db.Where("("+key+" "+op+" ?) OR ("+key+" = ? AND articles.id "+op+" ?)", after, after, q.AfterID)
+		}
 	}
-	db = db.Order("id desc")
-	if user.ID > 0 {
-		db = db.Where("id in (?)", db2.Table("article_favorites").Where("deleted_at is null").Where("user_id", user.ID).Select("article_id"))
+	db = db.Order(articleOrder(key, asc)).Offset(int(q.Offset))
+	if q.Limit > 0 {
+		db = db.Limit(int(q.Limit))
 	}
-	if tag.ID > 0 {
-		db = db.Where("id in (?)", db2.Table("article_tags").Where("deleted_at is null").Where("tag_id", tag.ID).Select("article_id"))
+	if q.KeyedSort() {
+		return r.listKeyed(ctx, db, key, count)
 	}
-
-	result := db.Model(Article{}).Debug().Find(&articles)
-
-	db.Count(&count)
-	if result.Error != nil {
-		return nil, count, result.Error
+	var articles []Article
+	if err := db.Preload("Tags").Scopes(preloadCoAuthors).Find(&articles).Error; err != nil {
+		return nil, 0, err
 	}
 	rv = make([]*biz.Article, len(articles))
 	for i, x := range articles {
@@ -114,91 +205,303 @@ func (r *articleRepo) List(ctx context.Context, los biz.ListOptions, opts ...biz
 	}
 	return rv, count, nil
 }
-func (r *articleRepo) CheckFavorited(uid uint, id uint) bool {
-	if err := r.data.db.Where(&ArticleFavorite{UserID: uid, ArticleID: id}).First(&ArticleFavorite{}).Error; err == nil {
+
+// listKeyed runs the page db of List sorted by key, reading the key of each
+// article with its id, and then loads the articles.
+func (r *articleRepo) listKeyed(ctx context.Context, db *gorm.DB, key string, count int64) ([]*biz.Article, int64, error) {
+	var page []struct {
+		ID      uint
+		SortKey int64
+	}
+	if err := db.Select("articles.id, " + key + " AS sort_key").Scan(&page).Error; err != nil {
+		return nil, 0, err
+	}
+	ids := make([]uint, len(page))
+	for i, x := range page {
+		ids[i] = x.ID
+	}
+	var articles []Article
+	if err := r.data.DB(ctx).Preload("Tags").Scopes(preloadCoAuthors).Where("id IN ?", ids).Find(&articles).Error; err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[uint]Article, len(articles))
+	for _, x := range articles {
+		byID[x.ID] = x
+	}
+	rv := make([]*biz.Article, 0, len(page))
+	for _, x := range page {
+		if a, ok := byID[x.ID]; ok {
+			rv = append(rv, convertArticle(a))
+			rv[len(rv)-1].SortKey = x.SortKey
+		}
+	}
+	return rv, count, nil
+}
+
+// articleSortKey is what q is sorted by before id, empty for creation order,
+// which is id order.
+func articleSortKey(q biz.ArticleQuery) string {
+	if q.SeriesID > 0 {
+		return "(SELECT position FROM series_articles WHERE series_articles.article_id = articles.id)"
+	}
+	switch q.Sort {
+	case biz.SortUpdated:
+		return "articles.updated_at"
+	case biz.SortFavorites:
+		// 和 GetArticle 一样按收藏记录数, 不用 favorites_count 列
+		return "(SELECT COUNT(*) FROM article_favorites WHERE article_favorites.article_id = articles.id" +
+			" AND article_favorites.deleted_at IS NULL)"
+	case biz.SortComments:
+		return "(SELECT COUNT(*) FROM comments WHERE comments.article_id = articles.id" +
+			" AND comments.deleted_at IS NULL)"
+	default:
+		return ""
+	}
+}
+
+// articleOrder is the ORDER BY of sort key key. Ties are broken by id.
+func articleOrder(key string, asc bool) string {
+	dir := " DESC"
+	if asc {
+		dir = " ASC"
+	}
+	if key == "" {
+		return "articles.id" + dir
+	}
+	return key + dir + ", articles.id" + dir
+}
+
+// filterArticles selects the articles matching the filters of q, without its
+// order and page.
+func filterArticles(ctx context.Context, d *Data, q biz.ArticleQuery) *gorm.DB {
+	db := d.DB(ctx).Model(&Article{})
+	if q.Unpublished {
+		db = db.Where("status <> ?", biz.StatusPublished)
+	} else {
+		db = db.Where("status = ?", biz.StatusPublished)
+	}
+	if len(q.IDs) > 0 {
+		db = db.Where("articles.id IN ?", q.IDs)
+	}
+	// 作者包括共同作者
+	if len(q.Author) > 0 {
+		users := d.DB(ctx).Model(&User{}).Where("username = ?", q.Author).Select("id")
+		db = db.Where(d.DB(ctx).Where("author_id IN (?)", users).Or("id IN (?)", d.DB(ctx).Model(&ArticleAuthor{}).
+			Where("user_id IN (?) AND accepted_at IS NOT NULL", users).Select("article_id")))
+	}
+	if q.AuthorID > 0 {
+		db = db.Where(d.DB(ctx).Where("author_id = ?", q.AuthorID).Or("id IN (?)", d.DB(ctx).Model(&ArticleAuthor{}).
+			Where("user_id = ? AND accepted_at IS NOT NULL", q.AuthorID).Select("article_id")))
+	}
+	if q.FeedOf > 0 {
+		db = db.Where(inFeed(ctx, d, q.FeedOf))
+	}
+	if q.MutedBy > 0 {
+		db = db.Where("author_id NOT IN (?)", mutedBy(ctx, d, q.MutedBy))
+	}
+	if q.HidePrivate {
+		visible := d.DB(ctx).Where("author_id NOT IN (?)", hiddenFrom(ctx, d, q.Viewer))
+		if q.Viewer > 0 {
+			visible = visible.Or("id IN (?)", d.DB(ctx).Model(&ArticleAuthor{}).
+				Where("user_id = ? AND accepted_at IS NOT NULL", q.Viewer).Select("article_id"))
+		}
+		db = db.Where(visible)
+	}
+	tagged := func(tags []string) *gorm.DB {
+		return d.DB(ctx).Table("article_tags").Joins("JOIN tags ON tags.id = article_tags.tag_id").
+			Where("tags.name IN ?", tags).Select("article_tags.article_id")
+	}
+	if len(q.Tags) > 0 {
+		if q.AllTags {
+			db = db.Where("id IN (?)", tagged(q.Tags).Group("article_tags.article_id").
+				Having("COUNT(*) = ?", len(biz.CleanTags(q.Tags...))))
+		} else {
+			db = db.Where("id IN (?)", tagged(q.Tags))
+		}
+	}
+	if len(q.ExcludeTags) > 0 {
+		db = db.Where("id NOT IN (?)", tagged(q.ExcludeTags))
+	}
+	if !q.CreatedAfter.IsZero() {
+		db = db.Where("created_at >= ?", q.CreatedAfter)
+	}
+	if !q.CreatedBefore.IsZero() {
+		db = db.Where("created_at < ?", q.CreatedBefore)
+	}
+	if q.SeriesID > 0 {
+		db = db.Where("id IN (?)", d.DB(ctx).Model(&SeriesArticle{}).Where("series_id = ?", q.SeriesID).Select("article_id"))
+	}
+	if len(q.FavoritedBy) > 0 {
+		db = db.Where("id IN (?)", d.DB(ctx).Model(&ArticleFavorite{}).
+			Joins("JOIN users ON users.id = article_favorites.user_id").
+			Where("users.username = ?", q.FavoritedBy).Select("article_favorites.article_id"))
+	}
+	return db
+}
+
+func (r *articleRepo) CheckFavorited(ctx context.Context, uid uint, id uint) bool {
+	if err := r.data.DB(ctx).Where(&ArticleFavorite{UserID: uid, ArticleID: id}).First(&ArticleFavorite{}).Error; err == nil {
 		return true
 	}
 	return false
 }
 
 func (r *articleRepo) Get(ctx context.Context, slug string) (rv *biz.Article, err error) {
-	x := Article{}
-	err = r.data.db.Where("id = ?", slug).Preload("Tags").First(&x).Error
+	id, err := r.SlugOwner(ctx, slug)
 	if err != nil {
 		return nil, err
 	}
-	fmt.Println("asdasdadad")
-	fmt.Printf("%+#v", x)
-	var fc int64
-	rv = convertArticle(x)
-	err = r.data.db.Model(&ArticleFavorite{}).Where("article_id = ?", x.ID).Count(&fc).Error
-	rv.FavoritesCount = uint32(fc)
-	return rv, nil
+	if id == 0 {
+		return nil, biz.ErrArticleNotFound
+	}
+	return r.GetArticle(ctx, id)
 }
 
-func (r *articleRepo) Create(ctx context.Context, a *biz.Article) (*biz.Article, error) {
-	tags := make([]Tag, 0)
-	for _, x := range a.TagList {
-		tags = append(tags, Tag{
-			Name: x,
-		})
+// SlugOwner looks in the current slugs first, soft deleted articles keep
+// theirs, then in the history. A slug never passes to another article, so the
+// cached ids are never invalidated.
+func (r *articleRepo) SlugOwner(ctx context.Context, slug string) (uint, error) {
+	var id uint
+	if r.data.cache.get(ctx, slugCacheKey(slug), &id) {
+		return id, nil
 	}
-	if len(tags) > 0 {
-		err := r.data.db.Clauses(clause.OnConflict{DoNothing: true}).Create(tags).Error
-		if err != nil {
-			return nil, err
+	var ids []uint
+	if err := r.data.DB(ctx).Unscoped().Model(&Article{}).Where("slug = ?", slug).Pluck("id", &ids).Error; err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		if err := r.data.DB(ctx).Model(&ArticleSlug{}).Where("slug = ?", slug).Pluck("article_id", &ids).Error; err != nil {
+			return 0, err
 		}
 	}
-
-	po := Article{
-		Slug:        a.Slug,
-		Title:       a.Title,
-		Description: a.Description,
-		Body:        a.Body,
-		AuthorID:    a.AuthorUserID,
-		Tags:        tags,
-		Username:    a.Username,
-		Email:       a.Email,
-		Image:       a.Image,
+	if len(ids) == 0 {
+		return 0, nil
 	}
-	result := r.data.db.Create(&po)
-	if result.Error != nil {
-		return nil, result.Error
+	r.data.cache.set(ctx, slugCacheKey(slug), ids[0])
+	return ids[0], nil
+}
+
+func (r *articleRepo) TitleTaken(ctx context.Context, title string, except uint) (bool, error) {
+	var n int64
+	err := r.data.DB(ctx).Unscoped().Model(&Article{}).Where("title = ? AND id <> ?", title, except).Count(&n).Error
+	return n > 0, err
+}
+
+func (r *articleRepo) Create(ctx context.Context, a *biz.Article) (*biz.Article, error) {
+	var po Article
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		tags, err := r.saveTags(ctx, a.TagList)
+		if err != nil {
+			return err
+		}
+
+		po = Article{
+			Slug:        a.Slug,
+			Title:       a.Title,
+			Description: a.Description,
+			Body:        a.Body,
+			AuthorID:    a.AuthorUserID,
+			Tags:        tags,
+			Status:      string(a.Status),
+			PublishAt:   publishAt(a.PublishAt),
+		}
+		po.setRendering(a.Rendering)
+		return r.data.DB(ctx).Create(&po).Error
+	})
+	if err != nil {
+		return nil, err
 	}
+	r.data.cache.del(ctx, tagsCacheKey)
 
 	return convertArticle(po), nil
 }
 
-func (r *articleRepo) Update(ctx context.Context, a *biz.Article) (*biz.Article, error) {
-	var po Article
-	if result := r.data.db.Where("id = ?", a.Slug).First(&po); result.Error != nil {
-		return nil, result.Error
+// saveTags inserts the tags that don't exist yet and returns all of them with
+// their ids. Not every dialect reports ids for rows skipped by ON CONFLICT, so
+// the tags are read back by name instead of relying on the insert.
+func (r *articleRepo) saveTags(ctx context.Context, names []string) ([]Tag, error) {
+	tags := make([]Tag, 0, len(names))
+	if len(names) == 0 {
+		return tags, nil
 	}
-	tags := make([]Tag, 0)
-	for _, x := range a.TagList {
+	for _, x := range names {
 		tags = append(tags, Tag{
 			Name: x,
 		})
 	}
-	if len(tags) > 0 {
-		err := r.data.db.Clauses(clause.OnConflict{DoNothing: true}).Create(tags).Error
+	if err := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		return nil, err
+	}
+	tags = tags[:0]
+	if err := r.data.DB(ctx).Where("name IN ?", names).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (r *articleRepo) Update(ctx context.Context, a *biz.Article) (*biz.Article, error) {
+	return r.update(ctx, a)
+}
+
+func (r *articleRepo) Restore(ctx context.Context, a *biz.Article) (*biz.Article, error) {
+	return r.update(ctx, a, "title", "description", "body", "body_html", "toc")
+}
+
+// update saves a, columns being written even when empty.
+func (r *articleRepo) update(ctx context.Context, a *biz.Article, columns ...string) (*biz.Article, error) {
+	var po Article
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if result := r.data.DB(ctx).Where("id = ?", a.ID).First(&po); result.Error != nil {
+			return result.Error
+		}
+		if a.Slug != po.Slug {
+			if err := r.renameSlug(ctx, po.ID, po.Slug, a.Slug); err != nil {
+				return err
+			}
+			po.Slug = a.Slug
+		}
+		tags, err := r.saveTags(ctx, a.TagList)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		po.Tags = tags
+		po.Title = a.Title
+		po.Description = a.Description
+		po.Body = a.Body
+		po.Status = string(a.Status)
+		po.PublishAt = publishAt(a.PublishAt)
+		po.setRendering(a.Rendering)
+
+		// 删除全部旧的tag
+		if err := r.data.DB(ctx).Table("article_tags").Where("article_id = ?", po.ID).Delete(&struct{}{}).Error; err != nil {
+			return err
+		}
+		if err := r.data.DB(ctx).Where("id = ?", po.ID).Session(&gorm.Session{FullSaveAssociations: true}).Updates(&po).Error; err != nil {
+			return err
+		}
+		// Updates 跳过空值, 草稿的 publish_at 和为 0 的字数要单独写
+		columns = append([]string{"publish_at", "word_count", "reading_time"}, columns...)
+		return r.data.DB(ctx).Model(&po).Select(columns).UpdateColumns(&po).Error
+	})
+	if err != nil {
+		return nil, err
 	}
-	po.Tags = tags
-	po.Title = a.Title
-	po.Description = a.Description
-	po.Body = a.Body
+	r.data.cache.del(ctx, articleIdCacheKey(po.ID), tagsCacheKey)
+	return convertArticle(po), nil
+}
 
-	// 删除全部旧的tag
-	r.data.db.Table("article_tags").Where("article_id", a.Slug).Delete(&struct{}{})
-	err := r.data.db.Where("id = ?", a.Slug).Session(&gorm.Session{FullSaveAssociations: true}).Updates(&po).Error
-	return convertArticle(po), err
+// renameSlug keeps the old slug of article id in the history, which the new
+// slug leaves when the article gets a slug back it had before.
+func (r *articleRepo) renameSlug(ctx context.Context, id uint, old, slug string) error {
+	if err := r.data.DB(ctx).Where("article_id = ? AND slug = ?", id, slug).Delete(&ArticleSlug{}).Error; err != nil {
+		return err
+	}
+	return r.data.DB(ctx).Create(&ArticleSlug{ArticleID: id, Slug: old}).Error
 }
 
 func (r *articleRepo) Delete(ctx context.Context, a *biz.Article) error {
-	rv := r.data.db.Delete(&Article{}, a.ID)
+	rv := r.data.DB(ctx).Delete(&Article{}, a.ID)
+	r.data.cache.del(ctx, articleIdCacheKey(a.ID))
 	return rv.Error
 }
 
@@ -208,26 +511,32 @@ func (r *articleRepo) Favorite(ctx context.Context, currentUserID uint, aid uint
 		ArticleID: aid,
 	}
 
-	var a Article
-	if err := r.data.db.Where("id = ?", aid).First(&a).Error; err != nil {
-		return err
-	}
-
-	if result := r.data.db.Where(&ArticleFavorite{UserID: currentUserID, ArticleID: aid}).First(&ArticleFavorite{}); result.RowsAffected == 0 {
-		err := r.data.db.Create(&af).Error
-		if err != nil {
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		var a Article
+		if err := r.data.DB(ctx).Where("id = ?", aid).First(&a).Error; err != nil {
 			return err
 		}
-		a.FavoritesCount += 1
-	} else {
-		if err := r.data.db.Where(&ArticleFavorite{UserID: currentUserID, ArticleID: aid}).Delete(&ArticleFavorite{}).Error; err != nil {
-			return err
+
+		if result := r.data.DB(ctx).Where(&ArticleFavorite{UserID: currentUserID, ArticleID: aid}).First(&ArticleFavorite{}); result.RowsAffected == 0 {
+			err := r.data.DB(ctx).Create(&af).Error
+			if err != nil {
+				return err
+			}
+			a.FavoritesCount += 1
+		} else {
+			if err := r.data.DB(ctx).Where(&ArticleFavorite{UserID: currentUserID, ArticleID: aid}).Delete(&ArticleFavorite{}).Error; err != nil {
+				return err
+			}
+			a.FavoritesCount -= 1
 		}
-		a.FavoritesCount -= 1
-	}
 
-	err := r.data.db.Model(&a).UpdateColumn("favorites_count", a.FavoritesCount).Error
-	return err
+		return r.data.DB(ctx).Model(&a).UpdateColumn("favorites_count", a.FavoritesCount).Error
+	})
+	if err != nil {
+		return err
+	}
+	r.data.cache.del(ctx, articleIdCacheKey(aid))
+	return nil
 }
 
 func (r *articleRepo) Unfavorite(ctx context.Context, currentUserID uint, aid uint) error {
@@ -235,30 +544,56 @@ func (r *articleRepo) Unfavorite(ctx context.Context, currentUserID uint, aid ui
 		UserID:    currentUserID,
 		ArticleID: aid,
 	}
-	err := r.data.db.Where(&ArticleFavorite{UserID: currentUserID, ArticleID: aid}).Delete(&po).Error
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		err := r.data.DB(ctx).Where(&ArticleFavorite{UserID: currentUserID, ArticleID: aid}).Delete(&po).Error
+		if err != nil {
+			return err
+		}
+		var a Article
+		if err := r.data.DB(ctx).Where("id = ?", aid).First(&a).Error; err != nil {
+			return err
+		}
+
+		return r.data.DB(ctx).Model(&a).UpdateColumn("favorites_count", a.FavoritesCount-1).Error
+	})
 	if err != nil {
 		return err
 	}
-	var a Article
-	if err := r.data.db.Where("id = ?", aid).First(&a).Error; err != nil {
-		return err
-	}
-
-	err = r.data.db.Model(&a).UpdateColumn("favorites_count", a.FavoritesCount-1).Error
-	return err
+	r.data.cache.del(ctx, articleIdCacheKey(aid))
+	return nil
 }
 
 func (r *articleRepo) GetFavoritesStatus(ctx context.Context, currentUserID uint, aa []*biz.Article) (favorited []bool, err error) {
-	var po ArticleFavorite
-	if result := r.data.db.First(&po); result.Error != nil {
-		return nil, nil
+	favorited = make([]bool, len(aa))
+	if len(aa) == 0 {
+		return favorited, nil
+	}
+	ids := make([]uint, len(aa))
+	for i, a := range aa {
+		ids[i] = a.ID
+	}
+	var found []uint
+	err = r.data.DB(ctx).Model(&ArticleFavorite{}).
+		Where("user_id = ? AND article_id IN ?", currentUserID, ids).Pluck("article_id", &found).Error
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[uint]bool, len(found))
+	for _, id := range found {
+		set[id] = true
 	}
-	return nil, nil
+	for i, a := range aa {
+		favorited[i] = set[a.ID]
+	}
+	return favorited, nil
 }
 
 func (r *articleRepo) ListTags(ctx context.Context) (rv []biz.Tag, err error) {
+	if r.data.cache.get(ctx, tagsCacheKey, &rv) {
+		return rv, nil
+	}
 	var tags []Tag
-	err = r.data.db.Find(&tags).Error
+	err = r.data.DB(ctx).Find(&tags).Error
 	if err != nil {
 		return nil, err
 	}
@@ -266,18 +601,65 @@ func (r *articleRepo) ListTags(ctx context.Context) (rv []biz.Tag, err error) {
 	for i, x := range tags {
 		rv[i] = biz.Tag(x.Name)
 	}
+	r.data.cache.set(ctx, tagsCacheKey, rv)
 	return rv, nil
 }
 
 func (r *articleRepo) GetArticle(ctx context.Context, aid uint) (rv *biz.Article, err error) {
+	rv = new(biz.Article)
+	if r.data.cache.get(ctx, articleIdCacheKey(aid), rv) {
+		return rv, nil
+	}
 	x := Article{}
-	err = r.data.db.Where("id = ?", aid).First(&x).Error
+	err = r.data.DB(ctx).Where("id = ?", aid).Preload("Tags").Scopes(preloadCoAuthors).First(&x).Error
+	// 软删除的文章在回收站, 这里也找不到
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrArticleNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 	var fc int64
 	rv = convertArticle(x)
-	err = r.data.db.Model(&ArticleFavorite{}).Where("article_id = ?", x.ID).Count(&fc).Error
+	if err := r.data.DB(ctx).Model(&ArticleFavorite{}).Where("article_id = ?", x.ID).Count(&fc).Error; err != nil {
+		return nil, err
+	}
 	rv.FavoritesCount = uint32(fc)
+	r.data.cache.set(ctx, articleIdCacheKey(aid), rv)
+	return rv, nil
+}
+
+// publishAt is the column value of biz.Article.PublishAt, NULL when zero.
+func publishAt(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// PublishDue reads from the primary, so that a lagging replica doesn't hide
+// due articles, and publishes each article with a conditional update: when
+// several instances run it at once, only one of them returns the article.
+func (r *articleRepo) PublishDue(ctx context.Context, now time.Time) ([]*biz.Article, error) {
+	var due []Article
+	err := r.data.DB(ctx).Clauses(dbresolver.Write).Preload("Tags").Scopes(preloadCoAuthors).
+		Where("status = ? AND publish_at <= ?", biz.StatusScheduled, now).Order("publish_at").Find(&due).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Article, 0, len(due))
+	for _, x := range due {
+		res := r.data.DB(ctx).Model(&Article{}).Where("id = ? AND status = ?", x.ID, biz.StatusScheduled).
+			UpdateColumn("status", biz.StatusPublished)
+		if res.Error != nil {
+			return rv, res.Error
+		}
+		if res.RowsAffected == 0 {
+			continue
+		}
+		r.data.cache.del(ctx, articleIdCacheKey(x.ID))
+		x.Status = string(biz.StatusPublished)
+		rv = append(rv, convertArticle(x))
+	}
 	return rv, nil
 }
@@ -0,0 +1,385 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"realworld/internal/biz"
+)
+
+func TestArticleFavorite(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar := NewArticleRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			a, b := createTestArticle(t, ar, alice, "first"), createTestArticle(t, ar, alice, "second")
+
+			check := func(favorited bool, count uint32) {
+				t.Helper()
+				if got := ar.CheckFavorited(ctx, bob, a.ID); got != favorited {
+					t.Errorf("CheckFavorited = %v, want %v", got, favorited)
+				}
+				got, err := ar.GetFavoritesStatus(ctx, bob, []*biz.Article{b, a})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if want := []bool{false, favorited}; !reflect.DeepEqual(got, want) {
+					t.Errorf("GetFavoritesStatus = %v, want %v", got, want)
+				}
+				rv, err := ar.GetArticle(ctx, a.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if rv.FavoritesCount != count {
+					t.Errorf("FavoritesCount = %d, want %d", rv.FavoritesCount, count)
+				}
+			}
+			check(false, 0)
+			if err := ar.Favorite(ctx, bob, a.ID); err != nil {
+				t.Fatal(err)
+			}
+			check(true, 1)
+			if err := ar.Unfavorite(ctx, bob, a.ID); err != nil {
+				t.Fatal(err)
+			}
+			check(false, 0)
+		})
+	}
+}
+
+func TestArticleSlugHistory(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar := NewArticleRepo(d, testLogger)
+			a := createTestArticle(t, ar, createTestUser(t, d, "alice"), "first")
+			owner := func(slug string, want uint) {
+				t.Helper()
+				id, err := ar.SlugOwner(ctx, slug)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if id != want {
+					t.Errorf("SlugOwner(%q) = %d, want %d", slug, id, want)
+				}
+			}
+			rename := func(slug string) {
+				t.Helper()
+				if _, err := ar.Update(ctx, &biz.Article{ID: a.ID, Slug: slug, Title: slug}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			owner("first", a.ID)
+			owner("second", 0)
+			rename("second")
+			owner("first", a.ID)
+			owner("second", a.ID)
+			rv, err := ar.Get(ctx, "first")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rv.ID != a.ID || rv.Slug != "second" {
+				t.Errorf("Get(first) = %d %q, want %d second", rv.ID, rv.Slug, a.ID)
+			}
+			// 改回以前的 slug
+			rename("first")
+			owner("first", a.ID)
+			owner("second", a.ID)
+			if rv, err = ar.Get(ctx, "second"); err != nil || rv.Slug != "first" {
+				t.Errorf("Get(second) = %v, %v", rv, err)
+			}
+
+			// 删掉的文章仍占着它的 slug
+			if err := ar.Delete(ctx, a); err != nil {
+				t.Fatal(err)
+			}
+			owner("first", a.ID)
+			owner("second", a.ID)
+			if _, err := ar.Get(ctx, "first"); err != biz.ErrArticleNotFound {
+				t.Errorf("Get of a deleted article: %v", err)
+			}
+			if _, err := ar.Get(ctx, "third"); err != biz.ErrArticleNotFound {
+				t.Errorf("Get of an unknown slug: %v", err)
+			}
+		})
+	}
+}
+
+func TestArticlePublishDue(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar := NewArticleRepo(d, testLogger)
+			uid := createTestUser(t, d, "alice")
+			at := time.Now().Add(time.Hour).Truncate(time.Second)
+			for i, title := range []string{"second", "first"} {
+				_, err := ar.Create(ctx, &biz.Article{Slug: title, Title: title, Status: biz.StatusScheduled,
+					PublishAt: at.Add(-time.Duration(i) * time.Minute), AuthorUserID: uid})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			createTestArticle(t, ar, uid, "published")
+
+			due := func(now time.Time, want ...string) {
+				t.Helper()
+				rv, err := ar.PublishDue(ctx, now)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := titles(rv); !reflect.DeepEqual(got, append([]string{}, want...)) {
+					t.Errorf("PublishDue(%v) = %q, want %q", now, got, want)
+				}
+			}
+			due(at.Add(-time.Hour))
+			due(at, "first", "second")
+			due(at.Add(time.Hour))
+			rv, _, err := ar.List(ctx, biz.ArticleQuery{Unpublished: true, AuthorID: uid})
+			if err != nil || len(rv) != 0 {
+				t.Errorf("unpublished after PublishDue = %q, %v", titles(rv), err)
+			}
+		})
+	}
+}
+
+func TestArticleListFilters(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar, cr := NewArticleRepo(d, testLogger), NewCommentRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			first := createTestArticle(t, ar, alice, "first", "go", "sql")
+			second := createTestArticle(t, ar, alice, "second", "go")
+			third := createTestArticle(t, ar, alice, "third", "sql", "draft")
+			for _, id := range []uint{second.ID, third.ID} {
+				if err := ar.Favorite(ctx, bob, id); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := ar.Favorite(ctx, alice, second.ID); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := cr.Create(ctx, &biz.Comment{Body: "body", ArticleID: third.ID, AuthorID: bob}); err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(10 * time.Millisecond)
+			if _, err := ar.Update(ctx, &biz.Article{ID: first.ID, Body: "edited", TagList: first.TagList, Status: biz.StatusPublished}); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, c := range []struct {
+				q    biz.ArticleQuery
+				want []string
+			}{
+				{biz.ArticleQuery{}, []string{"third", "second", "first"}},
+				{biz.ArticleQuery{Ascending: true}, []string{"first", "second", "third"}},
+				{biz.ArticleQuery{Tags: []string{"go", "sql"}}, []string{"third", "second", "first"}},
+				{biz.ArticleQuery{Tags: []string{"go", "sql"}, AllTags: true}, []string{"first"}},
+				{biz.ArticleQuery{ExcludeTags: []string{"draft"}}, []string{"second", "first"}},
+				{biz.ArticleQuery{Tags: []string{"sql"}, ExcludeTags: []string{"go"}}, []string{"third"}},
+				{biz.ArticleQuery{CreatedAfter: second.CreatedAt}, []string{"third", "second"}},
+				{biz.ArticleQuery{CreatedBefore: second.CreatedAt}, []string{"first"}},
+				{biz.ArticleQuery{Sort: biz.SortUpdated}, []string{"first", "third", "second"}},
+				{biz.ArticleQuery{Sort: biz.SortFavorites}, []string{"second", "third", "first"}},
+				{biz.ArticleQuery{Sort: biz.SortFavorites, Ascending: true}, []string{"first", "third", "second"}},
+				{biz.ArticleQuery{Sort: biz.SortComments}, []string{"third", "second", "first"}},
+				{biz.ArticleQuery{Sort: biz.SortComments, Limit: 1, Offset: 1}, []string{"second"}},
+			} {
+				rv, count, err := ar.List(ctx, c.q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := titles(rv); !reflect.DeepEqual(got, c.want) {
+					t.Errorf("%+v: %q, want %q", c.q, got, c.want)
+				}
+				if c.q.Limit == 0 && count != int64(len(c.want)) {
+					t.Errorf("%+v: count %d, want %d", c.q, count, len(c.want))
+				}
+			}
+		})
+	}
+}
+
+func TestArticleListAfterID(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar := NewArticleRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			var ids []uint
+			for _, title := range []string{"a1", "a2", "a3", "a4"} {
+				ids = append(ids, createTestArticle(t, ar, alice, title).ID)
+			}
+			for _, id := range []uint{ids[0], ids[2]} {
+				if err := ar.Favorite(ctx, bob, id); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for _, c := range []struct {
+				q    biz.ArticleQuery
+				want []string
+			}{
+				{biz.ArticleQuery{AfterID: ids[2]}, []string{"a2", "a1"}},
+				{biz.ArticleQuery{AfterID: ids[1], Ascending: true}, []string{"a3", "a4"}},
+				// 收藏数 a3 a1 a4 a2
+				{biz.ArticleQuery{AfterID: ids[0], AfterKey: 1, Sort: biz.SortFavorites}, []string{"a4", "a2"}},
+				{biz.ArticleQuery{AfterID: ids[2], AfterKey: 1, Sort: biz.SortFavorites, Limit: 1}, []string{"a1"}},
+				{biz.ArticleQuery{AfterID: ids[3], Sort: biz.SortFavorites, Ascending: true}, []string{"a1", "a3"}},
+			} {
+				rv, count, err := ar.List(ctx, c.q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := titles(rv); !reflect.DeepEqual(got, c.want) || count != 0 {
+					t.Errorf("%+v: %q (%d), want %q", c.q, got, count, c.want)
+				}
+			}
+		})
+	}
+}
+
+func TestArticleListTags(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar := NewArticleRepo(d, testLogger)
+			uid := createTestUser(t, d, "alice")
+			createTestArticle(t, ar, uid, "first", "go", "sql")
+			createTestArticle(t, ar, uid, "second")
+			createTestArticle(t, ar, uid, "third", "sql")
+
+			for _, q := range []biz.ArticleQuery{{}, {Tags: []string{"sql"}}, {AuthorID: uid, Limit: 2}} {
+				rv, _, err := ar.List(ctx, q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, a := range rv {
+					one, err := ar.GetArticle(ctx, a.ID)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !reflect.DeepEqual(a.TagList, one.TagList) {
+						t.Errorf("%+v: List has tags %q for %s, GetArticle %q", q, a.TagList, a.Slug, one.TagList)
+					}
+				}
+			}
+			rv, _, err := ar.List(ctx, biz.ArticleQuery{Ascending: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got [][]string
+			for _, a := range rv {
+				got = append(got, a.TagList)
+			}
+			want := [][]string{{"go", "sql"}, {}, {"sql"}}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("tags = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestArticleRestore(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar := NewArticleRepo(d, testLogger)
+			a := createTestArticle(t, ar, createTestUser(t, d, "alice"), "first")
+			a.Rendering = &biz.Rendering{HTML: "<p>body</p>", WordCount: 1, ReadingTime: 1}
+			if _, err := ar.Update(ctx, a); err != nil {
+				t.Fatal(err)
+			}
+
+			// Update leaves empty fields as they are, Restore writes them
+			if _, err := ar.Update(ctx, &biz.Article{ID: a.ID, Slug: a.Slug, Title: a.Title, Status: a.Status}); err != nil {
+				t.Fatal(err)
+			}
+			rv, err := ar.GetArticle(ctx, a.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rv.Description != "description" || rv.Body != "body" {
+				t.Errorf("after Update: description %q, body %q", rv.Description, rv.Body)
+			}
+			if _, err := ar.Restore(ctx, &biz.Article{ID: a.ID, Slug: a.Slug, Title: a.Title, Status: a.Status, Rendering: &biz.Rendering{}}); err != nil {
+				t.Fatal(err)
+			}
+			rv, err = ar.GetArticle(ctx, a.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rv.Description != "" || rv.Body != "" {
+				t.Errorf("after Restore: description %q, body %q", rv.Description, rv.Body)
+			}
+			if rv.Rendering == nil || rv.Rendering.HTML != "" || rv.Rendering.WordCount != 0 {
+				t.Errorf("after Restore: rendering %+v", rv.Rendering)
+			}
+		})
+	}
+}
+
+func TestArticleListAfterKey(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar := NewArticleRepo(d, testLogger)
+			alice, bob, carol := createTestUser(t, d, "alice"), createTestUser(t, d, "bob"), createTestUser(t, d, "carol")
+			var ids []uint
+			for _, title := range []string{"a1", "a2", "a3", "a4"} {
+				ids = append(ids, createTestArticle(t, ar, alice, title).ID)
+			}
+			for _, f := range []struct{ uid, aid uint }{{bob, ids[0]}, {carol, ids[0]}, {bob, ids[1]}, {bob, ids[2]}} {
+				if err := ar.Favorite(ctx, f.uid, f.aid); err != nil {
+					t.Fatal(err)
+				}
+			}
+			q := biz.ArticleQuery{Sort: biz.SortFavorites, Limit: 2}
+			rv, _, err := ar.List(ctx, q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := titles(rv); !reflect.DeepEqual(got, []string{"a1", "a3"}) {
+				t.Fatalf("first page %q", got)
+			}
+			last := rv[len(rv)-1]
+			if last.SortKey != 1 {
+				t.Fatalf("sort key of %s = %d, want 1", last.Title, last.SortKey)
+			}
+
+			// the next page starts after the key of the cursor, not the one
+			// its article has now
+			if err := ar.Unfavorite(ctx, bob, last.ID); err != nil {
+				t.Fatal(err)
+			}
+			q.AfterID, q.AfterKey, q.Limit = last.ID, last.SortKey, 0
+			rv, _, err = ar.List(ctx, q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := titles(rv); !reflect.DeepEqual(got, []string{"a2", "a4", "a3"}) {
+				t.Errorf("next page %q", got)
+			}
+		})
+	}
+}
+
+func titles(articles []*biz.Article) []string {
+	rv := make([]string, 0, len(articles))
+	for _, a := range articles {
+		rv = append(rv, a.Title)
+	}
+	return rv
+}
@@ -0,0 +1,132 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"realworld/internal/biz"
+)
+
+// Block keeps BlockedID away from the profile and articles of UserID.
+type Block struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UserID    uint `gorm:"uniqueIndex:idx_blocks_user_blocked"`
+	BlockedID uint `gorm:"uniqueIndex:idx_blocks_user_blocked;index"`
+}
+
+// Mute hides the articles and comments of MutedID from UserID.
+type Mute struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UserID    uint `gorm:"uniqueIndex:idx_mutes_user_muted"`
+	MutedID   uint `gorm:"uniqueIndex:idx_mutes_user_muted"`
+}
+
+// mutedBy selects the ids of the users uid muted.
+func mutedBy(ctx context.Context, d *Data, uid uint) *gorm.DB {
+	return d.DB(ctx).Model(&Mute{}).Where("user_id = ?", uid).Select("muted_id")
+}
+
+func (r *ProfileRepo) BlockUser(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	err = r.data.ExecTx(ctx, func(ctx context.Context) error {
+		var n int64
+		if err := r.data.DB(ctx).Model(&Block{}).Where("user_id = ? AND blocked_id = ?", uid, rv.ID).Count(&n).Error; err != nil || n > 0 {
+			return err
+		}
+		if err := r.data.DB(ctx).Create(&Block{UserID: uid, BlockedID: rv.ID}).Error; err != nil {
+			return err
+		}
+		// 拉黑后双方都不再关注对方
+		for _, f := range []Follow{{UserId: uid, FollowId: rv.ID}, {UserId: rv.ID, FollowId: uid}} {
+			res := r.data.DB(ctx).Unscoped().Where(&f).Delete(&Follow{})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected > 0 {
+				if err := r.countFollow(ctx, f.UserId, f.FollowId, -1); err != nil {
+					return err
+				}
+			}
+			if err := r.data.DB(ctx).Where("user_id = ? AND follow_id = ?", f.UserId, f.FollowId).Delete(&FollowRequest{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.data.cache.del(ctx, followCacheKey(uid, rv.ID), followCacheKey(rv.ID, uid))
+	return r.GetProfile(ctx, uid, username)
+}
+
+func (r *ProfileRepo) UnblockUser(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.data.DB(ctx).Where("user_id = ? AND blocked_id = ?", uid, rv.ID).Delete(&Block{}).Error; err != nil {
+		return nil, err
+	}
+	return r.GetProfile(ctx, uid, username)
+}
+
+func (r *ProfileRepo) MuteUser(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	err = r.data.ExecTx(ctx, func(ctx context.Context) error {
+		var n int64
+		if err := r.data.DB(ctx).Model(&Mute{}).Where("user_id = ? AND muted_id = ?", uid, rv.ID).Count(&n).Error; err != nil || n > 0 {
+			return err
+		}
+		return r.data.DB(ctx).Create(&Mute{UserID: uid, MutedID: rv.ID}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetProfile(ctx, uid, username)
+}
+
+func (r *ProfileRepo) UnmuteUser(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.data.DB(ctx).Where("user_id = ? AND muted_id = ?", uid, rv.ID).Delete(&Mute{}).Error; err != nil {
+		return nil, err
+	}
+	return r.GetProfile(ctx, uid, username)
+}
+
+func (r *ProfileRepo) BlockedBy(ctx context.Context, uid uint, ids ...uint) (bool, error) {
+	var n int64
+	err := r.data.DB(ctx).Model(&Block{}).Where("blocked_id = ? AND user_id IN ?", uid, ids).Count(&n).Error
+	return n > 0, err
+}
+
+// relations returns which of the users ids uid blocks and mutes.
+func (r *ProfileRepo) relations(ctx context.Context, uid uint, ids []uint) (blocking, muting map[uint]bool, err error) {
+	var blocked, muted []uint
+	if err := r.data.DB(ctx).Model(&Block{}).Where("user_id = ? AND blocked_id IN ?", uid, ids).Pluck("blocked_id", &blocked).Error; err != nil {
+		return nil, nil, err
+	}
+	if err := r.data.DB(ctx).Model(&Mute{}).Where("user_id = ? AND muted_id IN ?", uid, ids).Pluck("muted_id", &muted).Error; err != nil {
+		return nil, nil, err
+	}
+	blocking, muting = make(map[uint]bool), make(map[uint]bool)
+	for _, id := range blocked {
+		blocking[id] = true
+	}
+	for _, id := range muted {
+		muting[id] = true
+	}
+	return blocking, muting, nil
+}
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"realworld/internal/biz"
+)
+
+func TestBlockAndMute(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			pr := NewProfileRepo(d, testLogger)
+			ar, cr := NewArticleRepo(d, testLogger), NewCommentRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			a := createTestArticle(t, ar, alice, "first")
+			if _, err := cr.Create(ctx, &biz.Comment{Body: "hi", ArticleID: a.ID, AuthorID: bob}); err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range []struct {
+				uid  uint
+				name string
+			}{{alice, "bob"}, {bob, "alice"}} {
+				if _, err := pr.FollowUser(ctx, f.uid, f.name); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for i := 0; i < 2; i++ {
+				p, err := pr.BlockUser(ctx, alice, "bob")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !p.Blocking || p.Following || p.FollowersCount != 0 || p.FollowingCount != 0 {
+					t.Errorf("block %d: %+v", i, p)
+				}
+			}
+			if p, err := pr.GetProfileById(ctx, alice); err != nil || p.FollowersCount != 0 || p.FollowingCount != 0 {
+				t.Errorf("alice after block: %+v, %v", p, err)
+			}
+			if blocked, err := pr.BlockedBy(ctx, bob, alice); err != nil || !blocked {
+				t.Errorf("BlockedBy(bob, alice) = %v, %v", blocked, err)
+			}
+			if blocked, err := pr.BlockedBy(ctx, alice, bob); err != nil || blocked {
+				t.Errorf("BlockedBy(alice, bob) = %v, %v", blocked, err)
+			}
+			if p, err := pr.UnblockUser(ctx, alice, "bob"); err != nil || p.Blocking {
+				t.Errorf("unblock: %+v, %v", p, err)
+			}
+			if blocked, err := pr.BlockedBy(ctx, bob, alice); err != nil || blocked {
+				t.Errorf("BlockedBy after unblock = %v, %v", blocked, err)
+			}
+
+			seen := func(articles, comments int) {
+				t.Helper()
+				rv, _, err := ar.List(ctx, biz.ArticleQuery{MutedBy: bob})
+				if err != nil {
+					t.Fatal(err)
+				}
+				cs, err := cr.List(ctx, biz.CommentQuery{ArticleID: a.ID, MutedBy: alice})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(rv) != articles || len(cs) != comments {
+					t.Errorf("%d articles and %d comments, want %d and %d", len(rv), len(cs), articles, comments)
+				}
+			}
+			seen(1, 1)
+			for _, m := range []struct {
+				uid  uint
+				name string
+			}{{bob, "alice"}, {alice, "bob"}} {
+				if p, err := pr.MuteUser(ctx, m.uid, m.name); err != nil || !p.Muting {
+					t.Fatalf("mute: %+v, %v", p, err)
+				}
+			}
+			seen(0, 0)
+			if _, err := pr.UnmuteUser(ctx, bob, "alice"); err != nil {
+				t.Fatal(err)
+			}
+			seen(1, 0)
+		})
+	}
+}
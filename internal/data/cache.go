@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultCacheTTL = 10 * time.Minute
+
+// cache is a cache-aside helper over redis. Every method is a no-op when no
+// redis address is configured, and redis errors only cost a trip to the db.
+type cache struct {
+	rdb *redis.Client
+	ttl time.Duration
+	log *log.Helper
+	// sticky is set when reads go to replicas, see del.
+	sticky *stickyUsers
+}
+
+func newCache(c *conf.Data_Redis, logger log.Logger) *cache {
+	rc := &cache{ttl: defaultCacheTTL, log: log.NewHelper(logger)}
+	if c == nil || c.Addr == "" {
+		return rc
+	}
+	rc.rdb = redis.NewClient(&redis.Options{
+		Network:      c.Network,
+		Addr:         c.Addr,
+		ReadTimeout:  c.ReadTimeout.AsDuration(),
+		WriteTimeout: c.WriteTimeout.AsDuration(),
+	})
+	if c.CacheTtl != nil {
+		rc.ttl = c.CacheTtl.AsDuration()
+	}
+	return rc
+}
+
+// get decodes the value at key into v and reports whether it was there.
+// Reads in a transaction skip the cache, which may not have seen its writes.
+func (c *cache) get(ctx context.Context, key string, v interface{}) bool {
+	if c.rdb == nil || txFromContext(ctx) != nil || c.sticky.stuck(ctx) {
+		return false
+	}
+	b, err := c.rdb.Get(ctx, key).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			c.log.WithContext(ctx).Warnf("cache get %s: %v", key, err)
+		}
+		return false
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		c.log.WithContext(ctx).Warnf("cache decode %s: %v", key, err)
+		return false
+	}
+	return true
+}
+
+// set stores v at key, unless it was read in a transaction that may still
+// roll back.
+func (c *cache) set(ctx context.Context, key string, v interface{}) {
+	if c.rdb == nil || txFromContext(ctx) != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		c.log.WithContext(ctx).Warnf("cache encode %s: %v", key, err)
+		return
+	}
+	if err := c.rdb.Set(ctx, key, b, c.ttl).Err(); err != nil {
+		c.log.WithContext(ctx).Warnf("cache set %s: %v", key, err)
+	}
+}
+
+// del invalidates keys, in a transaction only once it has committed. With
+// replicas, keys are deleted again once they have caught up, as a read from a
+// lagging replica may have put the old value back in the meantime.
+func (c *cache) del(ctx context.Context, keys ...string) {
+	if c.rdb == nil || len(keys) == 0 {
+		return
+	}
+	if st := txFromContext(ctx); st != nil {
+		st.afterCommit = append(st.afterCommit, func(ctx context.Context) { c.del(ctx, keys...) })
+		return
+	}
+	if err := c.rdb.Del(ctx, keys...).Err(); err != nil {
+		c.log.WithContext(ctx).Warnf("cache del %v: %v", keys, err)
+	}
+	if c.sticky != nil {
+		time.AfterFunc(c.sticky.window, func() {
+			if err := c.rdb.Del(context.Background(), keys...).Err(); err != nil {
+				c.log.Warnf("cache del %v: %v", keys, err)
+			}
+		})
+	}
+}
+
+func (c *cache) close() error {
+	if c.rdb == nil {
+		return nil
+	}
+	return c.rdb.Close()
+}
+
+const tagsCacheKey = "realworld:tags"
+
+func articleIdCacheKey(id uint) string {
+	return fmt.Sprintf("realworld:article:%d", id)
+}
+
+// slugCacheKey maps a slug to the id of its article.
+func slugCacheKey(slug string) string {
+	return "realworld:slug:" + slug
+}
+
+func profileCacheKey(username string) string {
+	return "realworld:profile:name:" + username
+}
+
+func profileIdCacheKey(uid uint) string {
+	return fmt.Sprintf("realworld:profile:id:%d", uid)
+}
+
+func followCacheKey(uid, followId uint) string {
+	return fmt.Sprintf("realworld:follow:%d:%d", uid, followId)
+}
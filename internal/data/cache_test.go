@@ -0,0 +1,186 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+// newTestCache returns a Data on sqlite caching in a fresh miniredis.
+func newTestCache(t *testing.T) (*Data, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	return newTestData(t, "sqlite", &conf.Data_Redis{Addr: mr.Addr()}), mr
+}
+
+// checkCached fails t unless key is in mr as cached is.
+func checkCached(t *testing.T, mr *miniredis.Miniredis, key string, cached bool) {
+	t.Helper()
+	if mr.Exists(key) != cached {
+		t.Errorf("%s cached: %v, want %v", key, !cached, cached)
+	}
+}
+
+func TestCacheArticle(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestCache(t)
+	ar := NewArticleRepo(d, testLogger)
+	alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+	a := createTestArticle(t, ar, alice, "first")
+	key := articleIdCacheKey(a.ID)
+
+	// 读一次写入缓存, 之后绕过 repo 改库也读到缓存的值
+	checkCached(t, mr, key, false)
+	if _, err := ar.GetArticle(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkCached(t, mr, key, true)
+	if err := d.db.Model(&Article{}).Where("id = ?", a.ID).UpdateColumn("title", "changed").Error; err != nil {
+		t.Fatal(err)
+	}
+	rv, err := ar.GetArticle(ctx, a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rv.Title != "first" {
+		t.Errorf("title = %q, want the cached one", rv.Title)
+	}
+
+	writes := []struct {
+		name string
+		fn   func() error
+	}{
+		{"Update", func() error {
+			_, err := ar.Update(ctx, &biz.Article{ID: a.ID, Slug: a.Slug, Title: "first"})
+			return err
+		}},
+		{"Favorite", func() error { return ar.Favorite(ctx, bob, a.ID) }},
+		{"Unfavorite", func() error { return ar.Unfavorite(ctx, bob, a.ID) }},
+		{"Delete", func() error { return ar.Delete(ctx, a) }},
+	}
+	for _, w := range writes {
+		if _, err := ar.GetArticle(ctx, a.ID); err != nil {
+			t.Fatal(err)
+		}
+		checkCached(t, mr, key, true)
+		if err := w.fn(); err != nil {
+			t.Fatalf("%s: %v", w.name, err)
+		}
+		if mr.Exists(key) {
+			t.Errorf("%s left the article cached", w.name)
+		}
+	}
+}
+
+func TestCacheProfile(t *testing.T) {
+	ctx := context.Background()
+	d, mr := newTestCache(t)
+	pr := NewProfileRepo(d, testLogger).(*ProfileRepo)
+	alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+	keys := []string{
+		profileCacheKey("bob"), profileIdCacheKey(bob),
+		profileCacheKey("alice"), profileIdCacheKey(alice),
+		followCacheKey(alice, bob),
+	}
+
+	// 读路径写入缓存
+	read := func() {
+		t.Helper()
+		for _, name := range []string{"alice", "bob"} {
+			if _, err := pr.GetProfile(ctx, alice, name); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, id := range []uint{alice, bob} {
+			if _, err := pr.GetProfileById(ctx, id); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, key := range keys {
+			checkCached(t, mr, key, true)
+		}
+	}
+
+	writes := []struct {
+		name string
+		fn   func() error
+	}{
+		{"FollowUser", func() error {
+			_, err := pr.FollowUser(ctx, alice, "bob")
+			return err
+		}},
+		{"UnFollowUser", func() error {
+			_, err := pr.UnFollowUser(ctx, alice, "bob")
+			return err
+		}},
+	}
+	for _, w := range writes {
+		read()
+		if err := w.fn(); err != nil {
+			t.Fatalf("%s: %v", w.name, err)
+		}
+		// 关注数也在资料里
+		for _, key := range keys {
+			if mr.Exists(key) {
+				t.Errorf("%s left %s cached", w.name, key)
+			}
+		}
+		p, err := pr.GetProfile(ctx, alice, "bob")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.Following != (w.name == "FollowUser") {
+			t.Errorf("following = %v after %s", p.Following, w.name)
+		}
+	}
+
+	read()
+	if err := NewUserRepo(d, testLogger).UpdateUser(ctx, bob, &biz.UpdateUser{Bio: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+	checkCached(t, mr, profileCacheKey("bob"), false)
+	checkCached(t, mr, profileIdCacheKey(bob), false)
+	p, err := pr.GetProfileById(ctx, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Bio != "hi" {
+		t.Errorf("bio = %q after UpdateUser", p.Bio)
+	}
+}
+
+func TestCacheWithoutRedis(t *testing.T) {
+	ctx := context.Background()
+	c := newCache(nil, testLogger)
+	c.set(ctx, "key", 1)
+	var v int
+	if c.get(ctx, "key", &v) {
+		t.Error("get hit without redis")
+	}
+	c.del(ctx, "key")
+	if err := c.close(); err != nil {
+		t.Error(err)
+	}
+
+	// the repos read the database every time
+	d := newTestData(t, "sqlite", nil)
+	ar := NewArticleRepo(d, testLogger)
+	a := createTestArticle(t, ar, createTestUser(t, d, "alice"), "first")
+	if _, err := ar.GetArticle(ctx, a.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.db.Model(&Article{}).Where("id = ?", a.ID).UpdateColumn("title", "changed").Error; err != nil {
+		t.Fatal(err)
+	}
+	rv, err := ar.GetArticle(ctx, a.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rv.Title != "changed" {
+		t.Errorf("title = %q, want the one in the database", rv.Title)
+	}
+}
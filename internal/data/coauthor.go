@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type coAuthorRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCoAuthorRepo(data *Data, logger log.Logger) biz.CoAuthorRepo {
+	if data.mem != nil {
+		return &memCoAuthorRepo{mem: data.mem}
+	}
+	return &coAuthorRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func convertCoAuthor(x ArticleAuthor) *biz.CoAuthor {
+	var acceptedAt time.Time
+	if x.AcceptedAt != nil {
+		acceptedAt = *x.AcceptedAt
+	}
+	return &biz.CoAuthor{
+		ArticleID:  x.ArticleID,
+		UserID:     x.UserID,
+		InvitedBy:  x.InvitedBy,
+		CreatedAt:  x.CreatedAt,
+		AcceptedAt: acceptedAt,
+	}
+}
+
+func (r *coAuthorRepo) Invite(ctx context.Context, c *biz.CoAuthor) error {
+	return r.data.DB(ctx).Create(&ArticleAuthor{ArticleID: c.ArticleID, UserID: c.UserID, InvitedBy: c.InvitedBy}).Error
+}
+
+func (r *coAuthorRepo) Get(ctx context.Context, articleID, userID uint) (*biz.CoAuthor, error) {
+	var x ArticleAuthor
+	err := r.data.DB(ctx).Where("article_id = ? AND user_id = ?", articleID, userID).First(&x).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertCoAuthor(x), nil
+}
+
+// Accept and Remove change the co-authors GetArticle caches.
+func (r *coAuthorRepo) Accept(ctx context.Context, articleID, userID uint) error {
+	err := r.data.DB(ctx).Model(&ArticleAuthor{}).Where("article_id = ? AND user_id = ?", articleID, userID).
+		Update("accepted_at", time.Now()).Error
+	r.data.cache.del(ctx, articleIdCacheKey(articleID))
+	return err
+}
+
+func (r *coAuthorRepo) Remove(ctx context.Context, articleID, userID uint) error {
+	err := r.data.DB(ctx).Where("article_id = ? AND user_id = ?", articleID, userID).Delete(&ArticleAuthor{}).Error
+	r.data.cache.del(ctx, articleIdCacheKey(articleID))
+	return err
+}
+
+func (r *coAuthorRepo) ListInvitations(ctx context.Context, userID uint) ([]*biz.CoAuthor, error) {
+	var rows []ArticleAuthor
+	err := r.data.DB(ctx).Where("user_id = ? AND accepted_at IS NULL", userID).
+		Where("article_id IN (?)", r.data.DB(ctx).Model(&Article{}).Select("id")).
+		Order("id desc").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.CoAuthor, len(rows))
+	for i, x := range rows {
+		rv[i] = convertCoAuthor(x)
+	}
+	return rv, nil
+}
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"realworld/internal/biz"
+)
+
+func TestCoAuthorArticles(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar, car := NewArticleRepo(d, testLogger), NewCoAuthorRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			a := createTestArticle(t, ar, alice, "first")
+			createTestArticle(t, ar, alice, "second")
+
+			byBob := func(want ...string) {
+				t.Helper()
+				for _, q := range []biz.ArticleQuery{{Author: "bob"}, {AuthorID: bob}} {
+					rv, count, err := ar.List(ctx, q)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if got := titles(rv); count != int64(len(want)) || !reflect.DeepEqual(got, append([]string{}, want...)) {
+						t.Errorf("%+v: %q (%d), want %q", q, got, count, want)
+					}
+				}
+			}
+			if err := car.Invite(ctx, &biz.CoAuthor{ArticleID: a.ID, UserID: bob, InvitedBy: alice}); err != nil {
+				t.Fatal(err)
+			}
+			byBob()
+			if err := car.Accept(ctx, a.ID, bob); err != nil {
+				t.Fatal(err)
+			}
+			byBob("first")
+			rv, err := ar.GetArticle(ctx, a.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(rv.CoAuthorIDs, []uint{bob}) {
+				t.Errorf("CoAuthorIDs = %v, want [%d]", rv.CoAuthorIDs, bob)
+			}
+			if err := car.Remove(ctx, a.ID, bob); err != nil {
+				t.Fatal(err)
+			}
+			byBob()
+			if _, err := car.Get(ctx, a.ID, bob); err != biz.ErrInvitationNotFound {
+				t.Errorf("Get after Remove: %v", err)
+			}
+		})
+	}
+}
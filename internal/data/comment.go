@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"errors"
 	"realworld/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -10,11 +11,9 @@ import (
 
 type Comment struct {
 	gorm.Model
-	ArticleSlug string
-	Article     Article `gorm:"references:Slug"`
-	Body        string
-	AuthorID    uint
-	Author      User
+	ArticleID uint `gorm:"index"`
+	Body      string
+	AuthorID  uint
 }
 
 type commentRepo struct {
@@ -23,6 +22,9 @@ type commentRepo struct {
 }
 
 func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
+	if data.mem != nil {
+		return &memCommentRepo{mem: data.mem}
+	}
 	return &commentRepo{
 		data: data,
 		log:  log.NewHelper(logger),
@@ -31,68 +33,63 @@ func NewCommentRepo(data *Data, logger log.Logger) biz.CommentRepo {
 
 func (r *commentRepo) Create(ctx context.Context, in *biz.Comment) (rv *biz.Comment, err error) {
 	c := Comment{
-		ArticleSlug: in.Article.Slug,
-		Body:        in.Body,
-		AuthorID:    in.AuthorID,
+		ArticleID: in.ArticleID,
+		Body:      in.Body,
+		AuthorID:  in.AuthorID,
 	}
-	result := r.data.db.Create(&c)
+	result := r.data.DB(ctx).Create(&c)
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	return convertComment(c), nil
+}
+
+// convertComment leaves Author to biz, which loads the authors of a list at once.
+func convertComment(x Comment) *biz.Comment {
 	return &biz.Comment{
-		ID:      c.ID,
-		Article: &biz.Article{},
-		Body:    c.Body,
-		Author: &biz.Profile{
-			Username: c.Author.Username,
-			Bio:      c.Author.Bio,
-			Image:    c.Author.Image,
-		},
-	}, nil
+		ID:        x.ID,
+		CreatedAt: x.CreatedAt,
+		UpdatedAt: x.UpdatedAt,
+		DeletedAt: x.DeletedAt.Time,
+		Body:      x.Body,
+		ArticleID: x.ArticleID,
+		AuthorID:  x.AuthorID,
+		Author:    &biz.Profile{ID: x.AuthorID},
+	}
 }
 
-func (r *commentRepo) List(ctx context.Context, slug string) (rv []*biz.Comment, err error) {
+func (r *commentRepo) List(ctx context.Context, q biz.CommentQuery) (rv []*biz.Comment, err error) {
 	var comments []Comment
-	result := r.data.db.Where("article_slug = ?", slug).Preload("Author").Find(&comments)
+	db := r.data.DB(ctx).Where("article_id = ? AND id > ?", q.ArticleID, q.AfterID).Order("id")
+	if q.MutedBy > 0 {
+		db = db.Where("author_id NOT IN (?)", mutedBy(ctx, r.data, q.MutedBy))
+	}
+	if q.Limit > 0 {
+		db = db.Limit(q.Limit)
+	}
+	result := db.Find(&comments)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	rv = make([]*biz.Comment, len(comments))
 	for i, x := range comments {
-		rv[i] = &biz.Comment{
-			ID:      x.ID,
-			Article: nil, // fixme
-			Body:    x.Body,
-			Author: &biz.Profile{
-				Username: x.Author.Username,
-				Bio:      x.Author.Bio,
-				Image:    x.Author.Image,
-			},
-		}
+		rv[i] = convertComment(x)
 	}
 	return rv, result.Error
 }
 
 func (r *commentRepo) Get(ctx context.Context, id uint) (*biz.Comment, error) {
 	var c Comment
-	result := r.data.db.First(&c, id)
+	result := r.data.DB(ctx).First(&c, id)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrCommentNotFound
+	}
 	if result.Error != nil {
 		return nil, result.Error
 	}
-	return &biz.Comment{
-		ID:        c.ID,
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
-		Body:      c.Body,
-		AuthorID:  c.AuthorID,
-		Author: &biz.Profile{
-			Username: c.Author.Username,
-			Bio:      c.Author.Bio,
-			Image:    c.Author.Image,
-		},
-	}, nil
+	return convertComment(c), nil
 }
 
 func (r *commentRepo) Delete(ctx context.Context, id uint) (err error) {
-	return r.data.db.Delete(&Comment{}, id).Error
+	return r.data.DB(ctx).Delete(&Comment{}, id).Error
 }
@@ -0,0 +1,127 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+const (
+	defaultPingTimeout = 2 * time.Second
+	minConnectDelay    = 500 * time.Millisecond
+	maxConnectDelay    = 30 * time.Second
+)
+
+var errNotReady = errors.New("database is not ready")
+
+// configurePool applies the pool settings of c to the primary connection.
+func configurePool(db *gorm.DB, c *conf.Data_Database) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if c.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(int(c.MaxOpenConns))
+	}
+	if c.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(int(c.MaxIdleConns))
+	}
+	if c.ConnMaxLifetime != nil {
+		sqlDB.SetConnMaxLifetime(c.ConnMaxLifetime.AsDuration())
+	}
+	return nil
+}
+
+func pingTimeout(c *conf.Data_Database) time.Duration {
+	if c.PingTimeout != nil && c.PingTimeout.AsDuration() > 0 {
+		return c.PingTimeout.AsDuration()
+	}
+	return defaultPingTimeout
+}
+
+func (d *Data) ping(ctx context.Context) error {
+	sqlDB, err := d.db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, d.pingTimeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
+
+// connectOnce reaches the database and checks that its schema is at the
+// version of this build, see `realworld migrate`. A wrong version is returned
+// as fatal, it won't fix itself; err is worth retrying.
+func (d *Data) connectOnce(ctx context.Context) (fatal, err error) {
+	if err := d.ping(ctx); err != nil {
+		return nil, err
+	}
+	err = checkSchema(d.db.Clauses(dbresolver.Write).Session(&gorm.Session{}))
+	var ve *SchemaVersionError
+	if errors.As(err, &ve) {
+		return err, nil
+	}
+	if err == nil {
+		d.ready.Store(true)
+	}
+	return nil, err
+}
+
+// connect retries connectOnce with exponential backoff after it failed with
+// err. The service keeps running meanwhile and Ready reports it as not
+// ready. A wrong schema version found then stops the process.
+func (d *Data) connect(ctx context.Context, logger log.Logger, err error) {
+	l := log.NewHelper(logger)
+	delay := minConnectDelay
+	for {
+		l.Warnf("database is not ready, retrying in %s: %v", delay, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = nextConnectDelay(delay)
+		var fatal error
+		if fatal, err = d.connectOnce(ctx); fatal != nil {
+			l.Fatalf("refusing to serve: %v", fatal)
+		}
+		if err == nil {
+			l.Info("database is ready")
+			return
+		}
+	}
+}
+
+// nextConnectDelay doubles delay up to maxConnectDelay.
+func nextConnectDelay(delay time.Duration) time.Duration {
+	if delay *= 2; delay > maxConnectDelay {
+		return maxConnectDelay
+	}
+	return delay
+}
+
+func checkSchema(db *gorm.DB) error {
+	m, err := NewMigrator(db)
+	if err != nil {
+		return err
+	}
+	return m.CheckVersion()
+}
+
+// Ready reports whether the database can serve requests: it has been reached
+// with the right schema once, and answers a ping now.
+func (d *Data) Ready(ctx context.Context) error {
+	if d.mem != nil {
+		return nil
+	}
+	if !d.ready.Load() {
+		return errNotReady
+	}
+	return d.ping(ctx)
+}
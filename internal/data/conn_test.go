@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"realworld/internal/conf"
+)
+
+func TestNextConnectDelay(t *testing.T) {
+	var got []time.Duration
+	for delay := minConnectDelay; len(got) < 8; delay = nextConnectDelay(delay) {
+		got = append(got, delay)
+	}
+	want := []time.Duration{
+		500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second,
+		8 * time.Second, 16 * time.Second, maxConnectDelay, maxConnectDelay,
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("delays = %v, want %v", got, want)
+		}
+	}
+}
+
+// waitReady polls d.Ready until it reports ready as want does.
+func waitReady(t *testing.T, d *Data, want bool, timeout time.Duration) {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for {
+		err := d.Ready(context.Background())
+		if (err == nil) == want {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Ready = %v after %s", err, timeout)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestReady(t *testing.T) {
+	if err := newTestData(t, MemoryDriver, nil).Ready(context.Background()); err != nil {
+		t.Errorf("memory driver not ready: %v", err)
+	}
+
+	// 库没迁移就拒绝启动, 不在后台重试
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dns: filepath.Join(t.TempDir(), "realworld.db")}}
+	db := NewDb(c, testLogger)
+	var ve *SchemaVersionError
+	if _, _, err := NewData(db, c, testLogger); !errors.As(err, &ve) {
+		t.Fatalf("NewData on an unmigrated database = %v, want a SchemaVersionError", err)
+	}
+
+	// 连不上时后台重试, 直到 ready
+	db = newTestDbAt(t, c.Database.Dns)
+	if _, err := newTestMigrator(t, db).Up(); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	d := &Data{db: db, cache: newCache(nil, testLogger), pingTimeout: defaultPingTimeout}
+	if err := d.Ready(ctx); err != errNotReady {
+		t.Errorf("Ready before connecting = %v, want %v", err, errNotReady)
+	}
+	go d.connect(ctx, testLogger, errors.New("connection refused"))
+	waitReady(t, d, true, 3*minConnectDelay)
+
+	// 连接断了就不再 ready
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Ready(ctx); err == nil {
+		t.Error("Ready with the database closed")
+	}
+}
+
+func TestNewDataUnknownVersion(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "realworld.db")
+	db := newTestDbAt(t, dsn)
+	m := newTestMigrator(t, db)
+	if _, err := m.Up(); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Create(&SchemaMigration{Version: m.latest() + 1, Name: "future", AppliedAt: time.Now()}).Error; err != nil {
+		t.Fatal(err)
+	}
+	c := &conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dns: dsn}}
+	var ve *SchemaVersionError
+	if _, _, err := NewData(NewDb(c, testLogger), c, testLogger); !errors.As(err, &ve) {
+		t.Errorf("NewData on a newer database = %v, want a SchemaVersionError", err)
+	}
+}
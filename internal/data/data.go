@@ -1,49 +1,157 @@
 package data
 
 import (
+	"context"
+	"realworld/internal/biz"
 	"realworld/internal/conf"
+	"sync/atomic"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/wire"
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewMysqlDb, NewProfileRepo, NewUserRepo, NewArticleRepo, NewCommentRepo)
+var ProviderSet = wire.NewSet(NewData, NewDb, NewTransaction, NewProfileRepo, NewUserRepo, NewArticleRepo, NewCommentRepo, NewRevisionRepo, NewSeriesRepo, NewCoAuthorRepo, NewTrashRepo, NewTimelineRepo, NewSearchIndex)
 
 // Data .
 type Data struct {
-	db *gorm.DB
+	db    *gorm.DB
+	cache *cache
+	// mem backs the repos instead of db with the memory driver.
+	mem *memStore
+	// sticky is set when reads go to replicas.
+	sticky *stickyUsers
+	// timelineSize is how far back the feed goes, see inFeed.
+	timelineSize int
+
+	ready       atomic.Bool
+	pingTimeout time.Duration
 }
 
 // NewData .
 func NewData(db *gorm.DB, c *conf.Data, logger log.Logger) (*Data, func(), error) {
+	if c.Database.Driver == MemoryDriver {
+		return &Data{db: db, cache: newCache(nil, logger), mem: newMemStore(timelineSize(c))}, func() {}, nil
+	}
+	d := &Data{db: db, cache: newCache(c.Redis, logger), pingTimeout: pingTimeout(c.Database), timelineSize: timelineSize(c)}
+	if len(c.Database.Replicas) > 0 {
+		d.sticky = newStickyUsers(c.Database.StickyWindow.AsDuration())
+		if err := d.sticky.register(db); err != nil {
+			return nil, nil, err
+		}
+		d.cache.sticky = d.sticky
+	}
+	ctx, cancel := context.WithCancel(context.Background())
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		cancel()
+		if err := d.cache.close(); err != nil {
+			log.NewHelper(logger).Error(err)
+		}
+		if sqlDB, err := d.db.DB(); err == nil {
+			if err := sqlDB.Close(); err != nil {
+				log.NewHelper(logger).Error(err)
+			}
+		}
+	}
+	// 结构版本不对就不启动, 连不上才在后台重试
+	fatal, err := d.connectOnce(ctx)
+	if fatal != nil {
+		cleanup()
+		return nil, nil, fatal
 	}
-	return &Data{db: db}, cleanup, nil
+	if err != nil {
+		go d.connect(ctx, logger, err)
+	} else {
+		log.NewHelper(logger).Info("database is ready")
+	}
+	return d, cleanup, nil
 }
 
-func NewMysqlDb(c *conf.Data, logger log.Logger) *gorm.DB {
-	db, err := gorm.Open(mysql.Open(c.Database.Dns), &gorm.Config{
-		DisableForeignKeyConstraintWhenMigrating: true,
+type txKey struct{}
+
+// txState is the transaction carried in the context of an ExecTx callback.
+type txState struct {
+	db          *gorm.DB
+	afterCommit []func(ctx context.Context)
+}
+
+func txFromContext(ctx context.Context) *txState {
+	st, _ := ctx.Value(txKey{}).(*txState)
+	return st
+}
+
+// NewTransaction .
+func NewTransaction(d *Data) biz.Transaction {
+	return d
+}
+
+// ExecTx runs fn in a database transaction that repos pick up through DB(ctx).
+// Calls nested in an ongoing transaction join it instead of starting another.
+func (d *Data) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if d.mem != nil {
+		return d.mem.ExecTx(ctx, fn)
+	}
+	if txFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+	st := &txState{}
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		st.db = tx
+		return fn(context.WithValue(ctx, txKey{}, st))
 	})
 	if err != nil {
-		panic("failed to connect database")
+		return err
 	}
-	InitDb(db)
-	return db
+	for _, f := range st.afterCommit {
+		f(ctx)
+	}
+	return nil
+}
+
+// DB returns the transaction of ctx if there is one, the plain db otherwise.
+// Reads of the plain db go to a replica, unless the user of ctx has just
+// written and must see it.
+func (d *Data) DB(ctx context.Context) *gorm.DB {
+	if st := txFromContext(ctx); st != nil {
+		return st.db
+	}
+	if d.sticky.stuck(ctx) {
+		return d.db.WithContext(ctx).Clauses(dbresolver.Write)
+	}
+	return d.db.WithContext(ctx)
+}
+
+// OpenDb opens the database selected by c.Database.Driver, defaulting to mysql.
+func OpenDb(c *conf.Data) (*gorm.DB, error) {
+	dialector, err := openDialector(c.Database.Driver, c.Database.Dns, c.Database.Options)
+	if err != nil {
+		return nil, err
+	}
+	db, err := gorm.Open(dialector, &gorm.Config{
+		DisableForeignKeyConstraintWhenMigrating: true,
+		DisableAutomaticPing:                     true,
+	})
+	if err != nil || c.Database.Driver == MemoryDriver {
+		return db, err
+	}
+	return db, configurePool(db, c.Database)
 }
 
-func InitDb(db *gorm.DB) {
-	if err := db.AutoMigrate(
-		&User{},
-		&Follow{},
-		&Article{},
-		&Comment{},
-		&ArticleFavorite{},
-	); err != nil {
+// NewDb opens the database without waiting for it, NewData connects in the
+// background until it is reachable and its schema is up to date.
+func NewDb(c *conf.Data, logger log.Logger) *gorm.DB {
+	db, err := OpenDb(c)
+	if err != nil {
 		panic(err)
 	}
+	if len(c.Database.Replicas) > 0 {
+		if err := useReplicas(db, c.Database); err != nil {
+			panic(err)
+		}
+	}
+	return db
 }
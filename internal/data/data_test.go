@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// testDrivers are the drivers the repo tests run against, sqlite standing in
+// for the SQL ones.
+var testDrivers = []string{"sqlite", MemoryDriver}
+
+var testLogger = log.NewStdLogger(io.Discard)
+
+// newTestData returns a Data on a fresh, migrated database of driver, caching
+// in the redis at rc unless it is nil.
+func newTestData(t *testing.T, driver string, rc *conf.Data_Redis) *Data {
+	t.Helper()
+	if driver == MemoryDriver {
+		return NewMemoryData(testLogger)
+	}
+	c := &conf.Data{
+		Database: &conf.Data_Database{Driver: driver, Dns: filepath.Join(t.TempDir(), "realworld.db")},
+		Redis:    rc,
+	}
+	db, err := OpenDb(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(); err != nil {
+		t.Fatal(err)
+	}
+	d, cleanup, err := NewData(db, c, testLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	return d
+}
+
+func createTestUser(t *testing.T, d *Data, name string) uint {
+	t.Helper()
+	u := &biz.User{Email: name + "@example.com", Username: name, PasswordHash: "x"}
+	if err := NewUserRepo(d, testLogger).CreateUser(context.Background(), u); err != nil {
+		t.Fatal(err)
+	}
+	return u.Id
+}
+
+func createTestArticle(t *testing.T, ar biz.ArticleRepo, authorID uint, title string, tags ...string) *biz.Article {
+	t.Helper()
+	a, err := ar.Create(context.Background(), &biz.Article{
+		Slug:         title,
+		Title:        title,
+		Description:  "description",
+		Body:         "body",
+		TagList:      tags,
+		Status:       biz.StatusPublished,
+		AuthorUserID: authorID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
@@ -0,0 +1,78 @@
+package data
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// openDialector builds the gorm dialector for driver, merging options into
+// dsn with the syntax that driver expects. None of them connect before the
+// first query, so the service can start while the database is down.
+func openDialector(driver, dsn string, options map[string]string) (gorm.Dialector, error) {
+	switch driver {
+	case "", "mysql":
+		// 不查询 VERSION(), 它只影响我们没有用到的 AutoMigrate 细节
+		return mysql.New(mysql.Config{
+			DSN:                       withQueryOptions(dsn, options),
+			SkipInitializeWithVersion: true,
+		}), nil
+	case "postgres":
+		return postgres.Open(withPostgresOptions(dsn, options)), nil
+	case "sqlite":
+		return sqlite.Open(withQueryOptions(dsn, options)), nil
+	case MemoryDriver:
+		return memoryDialector{}, nil
+	}
+	return nil, fmt.Errorf("unsupported database driver: %s", driver)
+}
+
+func sortedKeys(options map[string]string) []string {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// withQueryOptions appends options as url query parameters, which is how both
+// mysql (charset, parseTime, loc) and sqlite (_busy_timeout, _fk) take them.
+func withQueryOptions(dsn string, options map[string]string) string {
+	if len(options) == 0 {
+		return dsn
+	}
+	q := make([]string, 0, len(options))
+	for _, k := range sortedKeys(options) {
+		q = append(q, url.QueryEscape(k)+"="+url.QueryEscape(options[k]))
+	}
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	return dsn + sep + strings.Join(q, "&")
+}
+
+// withPostgresOptions appends options (sslmode, TimeZone, ...) to either a
+// postgres:// url or a keyword/value connection string.
+func withPostgresOptions(dsn string, options map[string]string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		return withQueryOptions(dsn, options)
+	}
+	kv := make([]string, 0, len(options)+1)
+	if dsn != "" {
+		kv = append(kv, dsn)
+	}
+	for _, k := range sortedKeys(options) {
+		v := strings.ReplaceAll(options[k], `\`, `\\`)
+		v = strings.ReplaceAll(v, `'`, `\'`)
+		kv = append(kv, fmt.Sprintf("%s='%s'", k, v))
+	}
+	return strings.Join(kv, " ")
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"realworld/internal/biz"
+)
+
+// FollowRequest asks FollowID, a private user, to let UserID follow them.
+type FollowRequest struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UserID    uint `gorm:"uniqueIndex:idx_follow_requests_follow_user,priority:2"`
+	FollowID  uint `gorm:"uniqueIndex:idx_follow_requests_follow_user,priority:1"`
+}
+
+func (r *ProfileRepo) RequestFollow(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	// 重复的请求什么也不做
+	err = r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&FollowRequest{UserID: uid, FollowID: rv.ID}).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetProfile(ctx, uid, username)
+}
+
+func (r *ProfileRepo) FollowRequests(ctx context.Context, uid uint, limit, offset int64) (ids []uint, count int64, err error) {
+	db := r.data.DB(ctx).Model(&FollowRequest{}).Where("follow_id = ?", uid)
+	if err := db.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	err = db.Order("id DESC").Offset(int(offset)).Limit(int(limit)).Pluck("user_id", &ids).Error
+	return ids, count, err
+}
+
+func (r *ProfileRepo) ApproveFollowRequest(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	err = r.data.ExecTx(ctx, func(ctx context.Context) error {
+		res := r.data.DB(ctx).Where("user_id = ? AND follow_id = ?", rv.ID, uid).Delete(&FollowRequest{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrFollowRequestNotFound
+		}
+		// 和 FollowUser 一样, 已经关注了就不再计数
+		res = r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&Follow{UserId: rv.ID, FollowId: uid})
+		if res.Error != nil || res.RowsAffected != 1 {
+			return res.Error
+		}
+		return r.countFollow(ctx, rv.ID, uid, 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.data.cache.del(ctx, followCacheKey(rv.ID, uid))
+	return r.GetProfile(ctx, uid, username)
+}
+
+func (r *ProfileRepo) DenyFollowRequest(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	res := r.data.DB(ctx).Where("user_id = ? AND follow_id = ?", rv.ID, uid).Delete(&FollowRequest{})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, biz.ErrFollowRequestNotFound
+	}
+	return r.GetProfile(ctx, uid, username)
+}
+
+func (r *ProfileRepo) SeesArticles(ctx context.Context, uid, id uint) (bool, error) {
+	if uid == id {
+		return true, nil
+	}
+	p, err := r.GetProfileById(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return !p.Private || uid > 0 && r.isFollowing(ctx, uid, id), nil
+}
+
+// requested returns which of the users ids uid asked to follow.
+func (r *ProfileRepo) requested(ctx context.Context, uid uint, ids []uint) (map[uint]bool, error) {
+	var asked []uint
+	if err := r.data.DB(ctx).Model(&FollowRequest{}).Where("user_id = ? AND follow_id IN ?", uid, ids).Pluck("follow_id", &asked).Error; err != nil {
+		return nil, err
+	}
+	rv := make(map[uint]bool, len(asked))
+	for _, id := range asked {
+		rv[id] = true
+	}
+	return rv, nil
+}
+
+// hiddenFrom selects the ids of the private users whose articles viewer may
+// not see, see biz.ArticleQuery.
+func hiddenFrom(ctx context.Context, d *Data, viewer uint) *gorm.DB {
+	return d.DB(ctx).Model(&User{}).Where("private = ? AND id <> ?", true, viewer).
+		Where("id NOT IN (?)", d.DB(ctx).Model(&Follow{}).Where("user_id = ?", viewer).Select("follow_id")).Select("id")
+}
+
+// hiddenOwners returns the ids hiddenFrom selects, for the search engines
+// that don't query the database.
+func (d *Data) hiddenOwners(ctx context.Context, viewer uint) ([]uint, error) {
+	if d.mem != nil {
+		defer d.mem.lock(ctx)()
+		var rv []uint
+		for id, u := range d.mem.users {
+			if u.Private && id != viewer && !d.mem.following(viewer, id) {
+				rv = append(rv, id)
+			}
+		}
+		return rv, nil
+	}
+	var rv []uint
+	err := hiddenFrom(ctx, d, viewer).Pluck("id", &rv).Error
+	return rv, err
+}
@@ -0,0 +1,184 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"realworld/internal/biz"
+)
+
+func TestPrivateAccount(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			pr, ur := NewProfileRepo(d, testLogger), NewUserRepo(d, testLogger)
+			ar := NewArticleRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			createTestArticle(t, ar, alice, "first")
+			private := true
+			if err := ur.UpdateUser(ctx, alice, &biz.UpdateUser{Private: &private}); err != nil {
+				t.Fatal(err)
+			}
+			visible := func(viewer uint, want ...string) {
+				t.Helper()
+				rv, _, err := ar.List(ctx, biz.ArticleQuery{HidePrivate: true, Viewer: viewer})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := titles(rv); !reflect.DeepEqual(got, append([]string{}, want...)) {
+					t.Errorf("%d sees %q, want %q", viewer, got, want)
+				}
+				sees, err := pr.SeesArticles(ctx, viewer, alice)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if sees != (len(want) > 0) {
+					t.Errorf("SeesArticles(%d) = %v", viewer, sees)
+				}
+			}
+			visible(0)
+			visible(bob)
+			visible(alice, "first")
+
+			for i := 0; i < 2; i++ {
+				if p, err := pr.RequestFollow(ctx, bob, "alice"); err != nil || !p.Requested || p.Following {
+					t.Fatalf("request %d: %+v, %v", i, p, err)
+				}
+			}
+			ids, count, err := pr.FollowRequests(ctx, alice, 10, 0)
+			if err != nil || count != 1 || !reflect.DeepEqual(ids, []uint{bob}) {
+				t.Errorf("requests = %v (%d), %v", ids, count, err)
+			}
+			if _, err := pr.ApproveFollowRequest(ctx, alice, "bob"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := pr.ApproveFollowRequest(ctx, alice, "bob"); err != biz.ErrFollowRequestNotFound {
+				t.Errorf("approve twice: %v", err)
+			}
+			if _, err := pr.DenyFollowRequest(ctx, alice, "bob"); err != biz.ErrFollowRequestNotFound {
+				t.Errorf("deny after approve: %v", err)
+			}
+			visible(bob, "first")
+			if p, err := pr.GetProfileById(ctx, alice); err != nil || p.FollowersCount != 1 {
+				t.Errorf("alice after approve: %+v, %v", p, err)
+			}
+			if u, err := ur.GetUserById(ctx, alice); err != nil || !u.Private {
+				t.Errorf("alice is not private: %+v, %v", u, err)
+			}
+		})
+	}
+}
+
+func TestApproveFollowRequestRace(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			pr := NewProfileRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			followers := func(want uint32) {
+				t.Helper()
+				p, err := pr.GetProfileById(ctx, alice)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if p.FollowersCount != want {
+					t.Errorf("alice has %d followers, want %d", p.FollowersCount, want)
+				}
+			}
+
+			if _, err := pr.RequestFollow(ctx, bob, "alice"); err != nil {
+				t.Fatal(err)
+			}
+			var wg sync.WaitGroup
+			errs := make(chan error, 8)
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := pr.ApproveFollowRequest(ctx, alice, "bob")
+					errs <- err
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			approved := 0
+			for err := range errs {
+				switch err {
+				case nil:
+					approved++
+				case biz.ErrFollowRequestNotFound:
+				default:
+					t.Error(err)
+				}
+			}
+			if approved != 1 {
+				t.Errorf("%d approvals passed, want 1", approved)
+			}
+			followers(1)
+
+			// 已经关注时批准请求不再计数
+			if _, err := pr.RequestFollow(ctx, bob, "alice"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := pr.ApproveFollowRequest(ctx, alice, "bob"); err != nil {
+				t.Fatal(err)
+			}
+			followers(1)
+		})
+	}
+}
+
+func TestGoingPublicDropsFollowRequests(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			pr, ur := NewProfileRepo(d, testLogger), NewUserRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			carol := createTestUser(t, d, "carol")
+			private := true
+			for _, uid := range []uint{alice, carol} {
+				if err := ur.UpdateUser(ctx, uid, &biz.UpdateUser{Private: &private}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, name := range []string{"alice", "carol"} {
+				if _, err := pr.RequestFollow(ctx, bob, name); err != nil {
+					t.Fatal(err)
+				}
+			}
+			requests := func(uid uint, want int64) {
+				t.Helper()
+				if _, count, err := pr.FollowRequests(ctx, uid, 10, 0); err != nil || count != want {
+					t.Errorf("%d has %d requests, %v, want %d", uid, count, err, want)
+				}
+			}
+
+			// 只改别的字段不动请求
+			if err := ur.UpdateUser(ctx, alice, &biz.UpdateUser{Bio: "hi"}); err != nil {
+				t.Fatal(err)
+			}
+			requests(alice, 1)
+			private = false
+			if err := ur.UpdateUser(ctx, alice, &biz.UpdateUser{Private: &private}); err != nil {
+				t.Fatal(err)
+			}
+			requests(alice, 0)
+			requests(carol, 1)
+			p, err := pr.GetProfile(ctx, bob, "alice")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Requested || p.Following {
+				t.Errorf("alice as seen by bob: requested %v, following %v", p.Requested, p.Following)
+			}
+			if _, err := pr.ApproveFollowRequest(ctx, alice, "bob"); err != biz.ErrFollowRequestNotFound {
+				t.Errorf("approving a dropped request: %v", err)
+			}
+		})
+	}
+}
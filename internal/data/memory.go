@@ -0,0 +1,206 @@
+package data
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// MemoryDriver keeps everything in process memory, for tests and demos. Data
+// is lost on exit and there are no migrations to run.
+const MemoryDriver = "memory"
+
+// NewMemoryData returns a Data whose repos are backed by memory only, so that
+// usecases can be exercised without a database.
+func NewMemoryData(logger log.Logger) *Data {
+	db, err := gorm.Open(memoryDialector{}, &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+	return &Data{db: db, cache: newCache(nil, logger), mem: newMemStore(defaultTimelineSize)}
+}
+
+type favoriteKey struct {
+	UserID    uint
+	ArticleID uint
+}
+
+type articleAuthorKey struct {
+	ArticleID uint
+	UserID    uint
+}
+
+type followKey struct {
+	UserID   uint
+	FollowID uint
+}
+
+// relationKey is a block or a mute of OtherID by UserID.
+type relationKey struct {
+	UserID  uint
+	OtherID uint
+}
+
+type timelineKey struct {
+	UserID    uint
+	ArticleID uint
+}
+
+// memTables holds the rows of the memory driver. Rows are stored by value and
+// slices in them are replaced rather than modified, so a shallow copy of the
+// maps is a consistent snapshot.
+type memTables struct {
+	seq      map[string]uint
+	users    map[uint]User
+	articles map[uint]Article
+	// articleSlugs is the slug history, and the slugs of deleted articles.
+	articleSlugs map[string]uint
+	tags         map[uint]Tag
+	articleTags  map[uint][]uint
+	favorites    map[favoriteKey]struct{}
+	// follows holds the id of each follow, in the order they were made.
+	follows map[followKey]uint
+	// followRequests is follows for the requests to follow private users.
+	followRequests map[followKey]uint
+	comments       map[uint]Comment
+	revisions      map[uint]ArticleRevision
+	series         map[uint]Series
+	// seriesArticles is by article id, an article being in one series at most.
+	seriesArticles map[uint]SeriesArticle
+	articleAuthors map[articleAuthorKey]ArticleAuthor
+	// trashedArticles and trashedComments are the deleted rows, out of
+	// articles and comments until they are restored.
+	trashedArticles map[uint]Article
+	trashedComments map[uint]Comment
+	// timelines holds the author id of each article in a timeline.
+	timelines map[timelineKey]uint
+	blocks    map[relationKey]struct{}
+	mutes     map[relationKey]struct{}
+}
+
+func newMemTables() memTables {
+	return memTables{
+		seq:             make(map[string]uint),
+		users:           make(map[uint]User),
+		articles:        make(map[uint]Article),
+		articleSlugs:    make(map[string]uint),
+		tags:            make(map[uint]Tag),
+		articleTags:     make(map[uint][]uint),
+		favorites:       make(map[favoriteKey]struct{}),
+		follows:         make(map[followKey]uint),
+		followRequests:  make(map[followKey]uint),
+		comments:        make(map[uint]Comment),
+		revisions:       make(map[uint]ArticleRevision),
+		series:          make(map[uint]Series),
+		seriesArticles:  make(map[uint]SeriesArticle),
+		articleAuthors:  make(map[articleAuthorKey]ArticleAuthor),
+		trashedArticles: make(map[uint]Article),
+		trashedComments: make(map[uint]Comment),
+		timelines:       make(map[timelineKey]uint),
+		blocks:          make(map[relationKey]struct{}),
+		mutes:           make(map[relationKey]struct{}),
+	}
+}
+
+func copyMap[K comparable, V any](m map[K]V) map[K]V {
+	rv := make(map[K]V, len(m))
+	for k, v := range m {
+		rv[k] = v
+	}
+	return rv
+}
+
+func (t memTables) clone() memTables {
+	return memTables{
+		seq:             copyMap(t.seq),
+		users:           copyMap(t.users),
+		articles:        copyMap(t.articles),
+		articleSlugs:    copyMap(t.articleSlugs),
+		tags:            copyMap(t.tags),
+		articleTags:     copyMap(t.articleTags),
+		favorites:       copyMap(t.favorites),
+		follows:         copyMap(t.follows),
+		followRequests:  copyMap(t.followRequests),
+		comments:        copyMap(t.comments),
+		revisions:       copyMap(t.revisions),
+		series:          copyMap(t.series),
+		seriesArticles:  copyMap(t.seriesArticles),
+		articleAuthors:  copyMap(t.articleAuthors),
+		trashedArticles: copyMap(t.trashedArticles),
+		trashedComments: copyMap(t.trashedComments),
+		timelines:       copyMap(t.timelines),
+		blocks:          copyMap(t.blocks),
+		mutes:           copyMap(t.mutes),
+	}
+}
+
+// nextID returns the next auto increment id of table.
+func (t memTables) nextID(table string) uint {
+	t.seq[table]++
+	return t.seq[table]
+}
+
+// memStore serializes access to the tables. A transaction holds the lock
+// until it ends and rolls back by restoring the snapshot taken at its start.
+type memStore struct {
+	mu sync.Mutex
+	memTables
+	// timelineSize is timelineSize of Data.
+	timelineSize int
+}
+
+type memTxKey struct{}
+
+func newMemStore(timelineSize int) *memStore {
+	return &memStore{memTables: newMemTables(), timelineSize: timelineSize}
+}
+
+func (s *memStore) inTx(ctx context.Context) bool {
+	st, _ := ctx.Value(memTxKey{}).(*memStore)
+	return st == s
+}
+
+// lock locks the store unless ctx belongs to a transaction, which already
+// holds the lock, and returns the matching unlock.
+func (s *memStore) lock(ctx context.Context) func() {
+	if s.inTx(ctx) {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+func (s *memStore) ExecTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.inTx(ctx) {
+		return fn(ctx)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snapshot := s.memTables.clone()
+	if err := fn(context.WithValue(ctx, memTxKey{}, s)); err != nil {
+		s.memTables = snapshot
+		return err
+	}
+	return nil
+}
+
+// memoryDialector stands in for the database of the memory driver, it never
+// builds or runs a statement.
+type memoryDialector struct{}
+
+func (memoryDialector) Name() string                                   { return MemoryDriver }
+func (memoryDialector) Initialize(*gorm.DB) error                      { return nil }
+func (memoryDialector) Migrator(*gorm.DB) gorm.Migrator                { return nil }
+func (memoryDialector) DataTypeOf(*schema.Field) string                { return "" }
+func (memoryDialector) DefaultValueOf(*schema.Field) clause.Expression { return clause.Expr{} }
+func (memoryDialector) BindVarTo(w clause.Writer, _ *gorm.Statement, _ interface{}) {
+	w.WriteByte('?')
+}
+func (memoryDialector) QuoteTo(w clause.Writer, s string) { w.WriteString(s) }
+func (memoryDialector) Explain(sql string, _ ...interface{}) string {
+	return sql
+}
@@ -0,0 +1,429 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"realworld/internal/biz"
+)
+
+type memArticleRepo struct {
+	mem *memStore
+}
+
+// slugOwner is articleRepo.SlugOwner. Deleted articles are removed from
+// articles, their slug stays in articleSlugs.
+func (t memTables) slugOwner(slug string) uint {
+	for _, a := range t.articles {
+		if a.Slug == slug {
+			return a.ID
+		}
+	}
+	return t.articleSlugs[slug]
+}
+
+func (t memTables) articleBySlug(slug string) (Article, bool) {
+	a, ok := t.articles[t.slugOwner(slug)]
+	return a, ok
+}
+
+func (t memTables) favoritesCount(aid uint) uint32 {
+	var n uint32
+	for k := range t.favorites {
+		if k.ArticleID == aid {
+			n++
+		}
+	}
+	return n
+}
+
+// convertArticle is convertArticle with the tags and favorites of a joined in.
+func (t memTables) convertArticle(a Article) *biz.Article {
+	a.Tags = make([]Tag, 0, len(t.articleTags[a.ID]))
+	for _, id := range t.articleTags[a.ID] {
+		a.Tags = append(a.Tags, t.tags[id])
+	}
+	a.FavoritesCount = t.favoritesCount(a.ID)
+	a.CoAuthors = t.coAuthors(a.ID)
+	return convertArticle(a)
+}
+
+// titleTaken counts the trashed articles, which keep their titles.
+func (t memTables) titleTaken(title string, except uint) bool {
+	for _, m := range []map[uint]Article{t.articles, t.trashedArticles} {
+		for _, a := range m {
+			if a.Title == title && a.ID != except {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// saveTags creates the tags that don't exist yet and returns the ids of all
+// of them in id order, like articleRepo.saveTags.
+func (t memTables) saveTags(names []string) []uint {
+	ids := make([]uint, 0, len(names))
+	for _, name := range names {
+		if id, ok := t.tagByName(name); ok {
+			ids = append(ids, id)
+			continue
+		}
+		now := time.Now()
+		id := t.nextID("tags")
+		t.tags[id] = Tag{Model: gorm.Model{ID: id, CreatedAt: now, UpdatedAt: now}, Name: name}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	rv := ids[:0]
+	for i, id := range ids {
+		if i == 0 || id != ids[i-1] {
+			rv = append(rv, id)
+		}
+	}
+	return rv
+}
+
+func (t memTables) tagByName(name string) (uint, bool) {
+	for id, x := range t.tags {
+		if x.Name == name {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+func (t memTables) userByUsername(username string) (User, bool) {
+	for _, u := range t.users {
+		if u.Username == username {
+			return u, true
+		}
+	}
+	return User{}, false
+}
+
+// filterArticles is filterArticles of articleRepo, in no particular order.
+func (s *memStore) filterArticles(q biz.ArticleQuery) []Article {
+	t := s.memTables
+	author, _ := t.userByUsername(q.Author)
+	favoritedBy, _ := t.userByUsername(q.FavoritedBy)
+	var feedStart uint
+	if q.FeedOf > 0 {
+		feedStart = t.feedStart(q.FeedOf, s.timelineSize)
+	}
+
+	var articles []Article
+	for _, a := range t.articles {
+		switch {
+		case q.Unpublished == (a.Status == string(biz.StatusPublished)),
+			len(q.IDs) > 0 && !containsID(q.IDs, a.ID),
+			len(q.Author) > 0 && (author.ID == 0 || !t.isAuthor(a, author.ID)),
+			q.AuthorID > 0 && !t.isAuthor(a, q.AuthorID),
+			q.FeedOf > 0 && !t.inFeed(q.FeedOf, a, feedStart),
+			q.MutedBy > 0 && t.muting(q.MutedBy, a.AuthorID),
+			q.HidePrivate && t.hidden(q.Viewer, a),
+			len(q.Tags) > 0 && !t.tagged(a.ID, q.Tags, q.AllTags),
+			len(q.ExcludeTags) > 0 && t.tagged(a.ID, q.ExcludeTags, false),
+			!q.CreatedAfter.IsZero() && a.CreatedAt.Before(q.CreatedAfter),
+			!q.CreatedBefore.IsZero() && !a.CreatedAt.Before(q.CreatedBefore),
+			len(q.FavoritedBy) > 0 && !t.favorited(favoritedBy.ID, a.ID),
+			q.SeriesID > 0 && t.seriesArticles[a.ID].SeriesID != q.SeriesID:
+			continue
+		}
+		articles = append(articles, a)
+	}
+	return articles
+}
+
+func (r *memArticleRepo) List(ctx context.Context, q biz.ArticleQuery) (rv []*biz.Article, count int64, err error) {
+	defer r.mem.lock(ctx)()
+	articles := r.mem.filterArticles(q)
+	keys := make(map[uint]int64, len(articles))
+	for _, a := range articles {
+		keys[a.ID] = r.mem.sortKey(q, a)
+	}
+	asc := q.Ascending || q.SeriesID > 0
+	// before reports whether x, y are listed in this order
+	before := func(x, y int64, xid, yid uint) bool {
+		if x == y {
+			x, y = int64(xid), int64(yid)
+		}
+		if asc {
+			return x < y
+		}
+		return x > y
+	}
+	sort.Slice(articles, func(i, j int) bool {
+		return before(keys[articles[i].ID], keys[articles[j].ID], articles[i].ID, articles[j].ID)
+	})
+	// 按游标翻页时不计数
+	if q.AfterID == 0 {
+		count = int64(len(articles))
+	} else {
+		// 和 SQL 一样比较游标里的排序值, 不再读游标的文章
+		key := q.AfterKey
+		switch {
+		case q.KeyedSort():
+		case q.Sort == biz.SortUpdated:
+			key = q.AfterUpdated.UnixNano()
+		default:
+			key = int64(q.AfterID)
+		}
+		i := sort.Search(len(articles), func(i int) bool {
+			return before(key, keys[articles[i].ID], q.AfterID, articles[i].ID)
+		})
+		articles = articles[i:]
+	}
+
+	start, end := int(q.Offset), len(articles)
+	if start > end {
+		start = end
+	}
+	if q.Limit > 0 && start+int(q.Limit) < end {
+		end = start + int(q.Limit)
+	}
+	rv = make([]*biz.Article, 0, end-start)
+	for _, a := range articles[start:end] {
+		x := r.mem.convertArticle(a)
+		if q.KeyedSort() {
+			x.SortKey = keys[a.ID]
+		}
+		rv = append(rv, x)
+	}
+	return rv, count, nil
+}
+
+// tagged reports whether article id has any of the tags, or all of them.
+func (t memTables) tagged(id uint, tags []string, all bool) bool {
+	for _, name := range tags {
+		tagID, ok := t.tagByName(name)
+		has := ok && containsID(t.articleTags[id], tagID)
+		if has != all {
+			return has
+		}
+	}
+	return all
+}
+
+// sortKey is what a is listed by in the order of q, like articleOrder. Ties
+// are broken by id.
+func (t memTables) sortKey(q biz.ArticleQuery, a Article) int64 {
+	if q.SeriesID > 0 {
+		return int64(t.seriesArticles[a.ID].Position)
+	}
+	switch q.Sort {
+	case biz.SortUpdated:
+		return a.UpdatedAt.UnixNano()
+	case biz.SortFavorites:
+		return int64(t.favoritesCount(a.ID))
+	case biz.SortComments:
+		return int64(t.commentsCount(a.ID))
+	default:
+		return int64(a.ID)
+	}
+}
+
+func (t memTables) commentsCount(aid uint) int {
+	var n int
+	for _, c := range t.comments {
+		if c.ArticleID == aid {
+			n++
+		}
+	}
+	return n
+}
+
+func (t memTables) following(uid, followID uint) bool {
+	_, ok := t.follows[followKey{UserID: uid, FollowID: followID}]
+	return ok
+}
+
+func (t memTables) favorited(uid, aid uint) bool {
+	_, ok := t.favorites[favoriteKey{UserID: uid, ArticleID: aid}]
+	return ok
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, x := range ids {
+		if x == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *memArticleRepo) Get(ctx context.Context, slug string) (*biz.Article, error) {
+	defer r.mem.lock(ctx)()
+	a, ok := r.mem.articleBySlug(slug)
+	if !ok {
+		return nil, biz.ErrArticleNotFound
+	}
+	return r.mem.convertArticle(a), nil
+}
+
+func (r *memArticleRepo) SlugOwner(ctx context.Context, slug string) (uint, error) {
+	defer r.mem.lock(ctx)()
+	return r.mem.slugOwner(slug), nil
+}
+
+func (r *memArticleRepo) GetArticle(ctx context.Context, aid uint) (*biz.Article, error) {
+	defer r.mem.lock(ctx)()
+	a, ok := r.mem.articles[aid]
+	if !ok {
+		return nil, biz.ErrArticleNotFound
+	}
+	return r.mem.convertArticle(a), nil
+}
+
+func (r *memArticleRepo) TitleTaken(ctx context.Context, title string, except uint) (bool, error) {
+	defer r.mem.lock(ctx)()
+	return r.mem.titleTaken(title, except), nil
+}
+
+func (r *memArticleRepo) Create(ctx context.Context, in *biz.Article) (*biz.Article, error) {
+	defer r.mem.lock(ctx)()
+	if r.mem.titleTaken(in.Title, 0) {
+		return nil, gorm.ErrDuplicatedKey
+	}
+	now := time.Now()
+	a := Article{
+		Model:       gorm.Model{ID: r.mem.nextID("articles"), CreatedAt: now, UpdatedAt: now},
+		Slug:        in.Slug,
+		Title:       in.Title,
+		Description: in.Description,
+		Body:        in.Body,
+		AuthorID:    in.AuthorUserID,
+		Status:      string(in.Status),
+		PublishAt:   publishAt(in.PublishAt),
+	}
+	a.setRendering(in.Rendering)
+	r.mem.articles[a.ID] = a
+	r.mem.articleTags[a.ID] = r.mem.saveTags(in.TagList)
+	return r.mem.convertArticle(a), nil
+}
+
+func (r *memArticleRepo) Update(ctx context.Context, in *biz.Article) (*biz.Article, error) {
+	return r.update(ctx, in, false)
+}
+
+func (r *memArticleRepo) Restore(ctx context.Context, in *biz.Article) (*biz.Article, error) {
+	return r.update(ctx, in, true)
+}
+
+// update saves in, also its empty description and body when exact.
+func (r *memArticleRepo) update(ctx context.Context, in *biz.Article, exact bool) (*biz.Article, error) {
+	defer r.mem.lock(ctx)()
+	a, ok := r.mem.articles[in.ID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if in.Slug != a.Slug {
+		delete(r.mem.articleSlugs, in.Slug)
+		r.mem.articleSlugs[a.Slug] = a.ID
+		a.Slug = in.Slug
+	}
+	// 和 gorm 的 Updates 一样, 空值不更新
+	if in.Title != "" {
+		if r.mem.titleTaken(in.Title, a.ID) {
+			return nil, gorm.ErrDuplicatedKey
+		}
+		a.Title = in.Title
+	}
+	if in.Description != "" || exact {
+		a.Description = in.Description
+	}
+	if in.Body != "" || exact {
+		a.Body = in.Body
+	}
+	a.Status = string(in.Status)
+	a.PublishAt = publishAt(in.PublishAt)
+	a.setRendering(in.Rendering)
+	a.UpdatedAt = time.Now()
+	r.mem.articles[a.ID] = a
+	r.mem.articleTags[a.ID] = r.mem.saveTags(in.TagList)
+	return r.mem.convertArticle(a), nil
+}
+
+// Delete moves the article to the trash, its slug to articleSlugs.
+func (r *memArticleRepo) Delete(ctx context.Context, in *biz.Article) error {
+	defer r.mem.lock(ctx)()
+	if a, ok := r.mem.articles[in.ID]; ok {
+		r.mem.articleSlugs[a.Slug] = a.ID
+		a.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		r.mem.trashedArticles[a.ID] = a
+	}
+	delete(r.mem.articles, in.ID)
+	return nil
+}
+
+func (r *memArticleRepo) CheckFavorited(ctx context.Context, uid uint, id uint) bool {
+	defer r.mem.lock(ctx)()
+	return r.mem.favorited(uid, id)
+}
+
+// Favorite toggles the favorite like articleRepo.Favorite does.
+func (r *memArticleRepo) Favorite(ctx context.Context, currentUserID uint, aid uint) error {
+	defer r.mem.lock(ctx)()
+	if _, ok := r.mem.articles[aid]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	k := favoriteKey{UserID: currentUserID, ArticleID: aid}
+	if _, ok := r.mem.favorites[k]; ok {
+		delete(r.mem.favorites, k)
+	} else {
+		r.mem.favorites[k] = struct{}{}
+	}
+	return nil
+}
+
+func (r *memArticleRepo) Unfavorite(ctx context.Context, currentUserID uint, aid uint) error {
+	defer r.mem.lock(ctx)()
+	if _, ok := r.mem.articles[aid]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	delete(r.mem.favorites, favoriteKey{UserID: currentUserID, ArticleID: aid})
+	return nil
+}
+
+func (r *memArticleRepo) GetFavoritesStatus(ctx context.Context, currentUserID uint, as []*biz.Article) (favorited []bool, err error) {
+	defer r.mem.lock(ctx)()
+	favorited = make([]bool, len(as))
+	for i, a := range as {
+		favorited[i] = r.mem.favorited(currentUserID, a.ID)
+	}
+	return favorited, nil
+}
+
+func (r *memArticleRepo) ListTags(ctx context.Context) ([]biz.Tag, error) {
+	defer r.mem.lock(ctx)()
+	ids := make([]uint, 0, len(r.mem.tags))
+	for id := range r.mem.tags {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	rv := make([]biz.Tag, len(ids))
+	for i, id := range ids {
+		rv[i] = biz.Tag(r.mem.tags[id].Name)
+	}
+	return rv, nil
+}
+
+func (r *memArticleRepo) PublishDue(ctx context.Context, now time.Time) ([]*biz.Article, error) {
+	defer r.mem.lock(ctx)()
+	var due []Article
+	for _, a := range r.mem.articles {
+		if a.Status == string(biz.StatusScheduled) && !a.PublishAt.After(now) {
+			due = append(due, a)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return due[i].PublishAt.Before(*due[j].PublishAt) })
+	rv := make([]*biz.Article, len(due))
+	for i, a := range due {
+		a.Status = string(biz.StatusPublished)
+		r.mem.articles[a.ID] = a
+		rv[i] = r.mem.convertArticle(a)
+	}
+	return rv, nil
+}
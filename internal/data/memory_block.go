@@ -0,0 +1,72 @@
+package data
+
+import (
+	"context"
+
+	"realworld/internal/biz"
+)
+
+func (t memTables) blocking(uid, id uint) bool {
+	_, ok := t.blocks[relationKey{UserID: uid, OtherID: id}]
+	return ok
+}
+
+func (t memTables) muting(uid, id uint) bool {
+	_, ok := t.mutes[relationKey{UserID: uid, OtherID: id}]
+	return ok
+}
+
+// relate adds or removes the relation of uid to username in rel and returns
+// the profile of username as uid sees it afterwards.
+func (r *memProfileRepo) relate(ctx context.Context, rel map[relationKey]struct{}, uid uint, username string, on bool) (*biz.Profile, error) {
+	defer r.mem.lock(ctx)()
+	rv, err := r.getByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	k := relationKey{UserID: uid, OtherID: rv.ID}
+	if on {
+		rel[k] = struct{}{}
+	} else {
+		delete(rel, k)
+	}
+	rv.Following = r.mem.following(uid, rv.ID)
+	rv.Blocking, rv.Muting = r.mem.blocking(uid, rv.ID), r.mem.muting(uid, rv.ID)
+	return rv, nil
+}
+
+func (r *memProfileRepo) BlockUser(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	rv, err := r.relate(ctx, r.mem.blocks, uid, username, true)
+	if err != nil {
+		return nil, err
+	}
+	defer r.mem.lock(ctx)()
+	delete(r.mem.follows, followKey{UserID: uid, FollowID: rv.ID})
+	delete(r.mem.follows, followKey{UserID: rv.ID, FollowID: uid})
+	delete(r.mem.followRequests, followKey{UserID: uid, FollowID: rv.ID})
+	delete(r.mem.followRequests, followKey{UserID: rv.ID, FollowID: uid})
+	rv.Following = false
+	return r.mem.counted(rv), nil
+}
+
+func (r *memProfileRepo) UnblockUser(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	return r.relate(ctx, r.mem.blocks, uid, username, false)
+}
+
+func (r *memProfileRepo) MuteUser(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	return r.relate(ctx, r.mem.mutes, uid, username, true)
+}
+
+func (r *memProfileRepo) UnmuteUser(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	return r.relate(ctx, r.mem.mutes, uid, username, false)
+}
+
+func (r *memProfileRepo) BlockedBy(ctx context.Context, uid uint, ids ...uint) (bool, error) {
+	defer r.mem.lock(ctx)()
+	for _, id := range ids {
+		if r.mem.blocking(id, uid) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
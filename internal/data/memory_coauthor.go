@@ -0,0 +1,99 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"realworld/internal/biz"
+)
+
+type memCoAuthorRepo struct {
+	mem *memStore
+}
+
+func (r *memCoAuthorRepo) Invite(ctx context.Context, c *biz.CoAuthor) error {
+	defer r.mem.lock(ctx)()
+	k := articleAuthorKey{ArticleID: c.ArticleID, UserID: c.UserID}
+	if _, ok := r.mem.articleAuthors[k]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	r.mem.articleAuthors[k] = ArticleAuthor{
+		ID:        r.mem.nextID("article_authors"),
+		CreatedAt: time.Now(),
+		ArticleID: c.ArticleID,
+		UserID:    c.UserID,
+		InvitedBy: c.InvitedBy,
+	}
+	return nil
+}
+
+func (r *memCoAuthorRepo) Get(ctx context.Context, articleID, userID uint) (*biz.CoAuthor, error) {
+	defer r.mem.lock(ctx)()
+	x, ok := r.mem.articleAuthors[articleAuthorKey{ArticleID: articleID, UserID: userID}]
+	if !ok {
+		return nil, biz.ErrInvitationNotFound
+	}
+	return convertCoAuthor(x), nil
+}
+
+func (r *memCoAuthorRepo) Accept(ctx context.Context, articleID, userID uint) error {
+	defer r.mem.lock(ctx)()
+	k := articleAuthorKey{ArticleID: articleID, UserID: userID}
+	if x, ok := r.mem.articleAuthors[k]; ok {
+		now := time.Now()
+		x.AcceptedAt = &now
+		r.mem.articleAuthors[k] = x
+	}
+	return nil
+}
+
+func (r *memCoAuthorRepo) Remove(ctx context.Context, articleID, userID uint) error {
+	defer r.mem.lock(ctx)()
+	delete(r.mem.articleAuthors, articleAuthorKey{ArticleID: articleID, UserID: userID})
+	return nil
+}
+
+func (r *memCoAuthorRepo) ListInvitations(ctx context.Context, userID uint) ([]*biz.CoAuthor, error) {
+	defer r.mem.lock(ctx)()
+	var rows []ArticleAuthor
+	for _, x := range r.mem.articleAuthors {
+		if _, ok := r.mem.articles[x.ArticleID]; ok && x.UserID == userID && x.AcceptedAt == nil {
+			rows = append(rows, x)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].ID > rows[j].ID })
+	rv := make([]*biz.CoAuthor, len(rows))
+	for i, x := range rows {
+		rv[i] = convertCoAuthor(x)
+	}
+	return rv, nil
+}
+
+// coAuthors returns the rows of the accepted co-authors of an article, in the
+// order of preloadCoAuthors.
+func (t memTables) coAuthors(articleID uint) []ArticleAuthor {
+	var rv []ArticleAuthor
+	for _, x := range t.articleAuthors {
+		if x.ArticleID == articleID && x.AcceptedAt != nil {
+			rv = append(rv, x)
+		}
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		if !rv[i].AcceptedAt.Equal(*rv[j].AcceptedAt) {
+			return rv[i].AcceptedAt.Before(*rv[j].AcceptedAt)
+		}
+		return rv[i].ID < rv[j].ID
+	})
+	return rv
+}
+
+// isAuthor reports whether user uid owns or co-authors article a.
+func (t memTables) isAuthor(a Article, uid uint) bool {
+	if a.AuthorID == uid {
+		return true
+	}
+	x, ok := t.articleAuthors[articleAuthorKey{ArticleID: a.ID, UserID: uid}]
+	return ok && x.AcceptedAt != nil
+}
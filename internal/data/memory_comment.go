@@ -0,0 +1,65 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"realworld/internal/biz"
+)
+
+type memCommentRepo struct {
+	mem *memStore
+}
+
+func (r *memCommentRepo) Create(ctx context.Context, in *biz.Comment) (*biz.Comment, error) {
+	defer r.mem.lock(ctx)()
+	now := time.Now()
+	c := Comment{
+		Model:     gorm.Model{ID: r.mem.nextID("comments"), CreatedAt: now, UpdatedAt: now},
+		ArticleID: in.ArticleID,
+		Body:      in.Body,
+		AuthorID:  in.AuthorID,
+	}
+	r.mem.comments[c.ID] = c
+	return convertComment(c), nil
+}
+
+func (r *memCommentRepo) List(ctx context.Context, q biz.CommentQuery) ([]*biz.Comment, error) {
+	defer r.mem.lock(ctx)()
+	var comments []Comment
+	for _, c := range r.mem.comments {
+		if c.ArticleID == q.ArticleID && c.ID > q.AfterID && !(q.MutedBy > 0 && r.mem.muting(q.MutedBy, c.AuthorID)) {
+			comments = append(comments, c)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool { return comments[i].ID < comments[j].ID })
+	if q.Limit > 0 && len(comments) > q.Limit {
+		comments = comments[:q.Limit]
+	}
+	rv := make([]*biz.Comment, len(comments))
+	for i, x := range comments {
+		rv[i] = convertComment(x)
+	}
+	return rv, nil
+}
+
+func (r *memCommentRepo) Get(ctx context.Context, id uint) (*biz.Comment, error) {
+	defer r.mem.lock(ctx)()
+	c, ok := r.mem.comments[id]
+	if !ok {
+		return nil, biz.ErrCommentNotFound
+	}
+	return convertComment(c), nil
+}
+
+func (r *memCommentRepo) Delete(ctx context.Context, id uint) error {
+	defer r.mem.lock(ctx)()
+	if c, ok := r.mem.comments[id]; ok {
+		c.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		r.mem.trashedComments[id] = c
+	}
+	delete(r.mem.comments, id)
+	return nil
+}
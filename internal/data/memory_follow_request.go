@@ -0,0 +1,78 @@
+package data
+
+import (
+	"context"
+
+	"realworld/internal/biz"
+)
+
+func (t memTables) requested(uid, id uint) bool {
+	_, ok := t.followRequests[followKey{UserID: uid, FollowID: id}]
+	return ok
+}
+
+// hidden reports whether hiddenFrom drops article a for viewer.
+func (t memTables) hidden(viewer uint, a Article) bool {
+	u := t.users[a.AuthorID]
+	return u.Private && !t.isAuthor(a, viewer) && !t.following(viewer, a.AuthorID)
+}
+
+func (r *memProfileRepo) RequestFollow(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	defer r.mem.lock(ctx)()
+	rv, err := r.getByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	k := followKey{UserID: uid, FollowID: rv.ID}
+	if _, ok := r.mem.followRequests[k]; !ok {
+		r.mem.followRequests[k] = r.mem.nextID("follow_requests")
+	}
+	rv.Blocking, rv.Muting, rv.Requested = r.mem.blocking(uid, rv.ID), r.mem.muting(uid, rv.ID), true
+	return rv, nil
+}
+
+func (r *memProfileRepo) FollowRequests(ctx context.Context, uid uint, limit, offset int64) ([]uint, int64, error) {
+	defer r.mem.lock(ctx)()
+	var count int64
+	for k := range r.mem.followRequests {
+		if k.FollowID == uid {
+			count++
+		}
+	}
+	return followPage(r.mem.followRequests, limit, offset, func(k followKey) (uint, bool) { return k.UserID, k.FollowID == uid }), count, nil
+}
+
+// answer drops the request of username to follow uid, and makes it a follow
+// if approve is set.
+func (r *memProfileRepo) answer(ctx context.Context, uid uint, username string, approve bool) (*biz.Profile, error) {
+	defer r.mem.lock(ctx)()
+	rv, err := r.getByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	k := followKey{UserID: rv.ID, FollowID: uid}
+	if _, ok := r.mem.followRequests[k]; !ok {
+		return nil, biz.ErrFollowRequestNotFound
+	}
+	delete(r.mem.followRequests, k)
+	if _, ok := r.mem.follows[k]; approve && !ok {
+		r.mem.follows[k] = r.mem.nextID("follows")
+	}
+	rv.Following = r.mem.following(uid, rv.ID)
+	rv.Blocking, rv.Muting = r.mem.blocking(uid, rv.ID), r.mem.muting(uid, rv.ID)
+	rv.Requested = r.mem.requested(uid, rv.ID)
+	return r.mem.counted(rv), nil
+}
+
+func (r *memProfileRepo) ApproveFollowRequest(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	return r.answer(ctx, uid, username, true)
+}
+
+func (r *memProfileRepo) DenyFollowRequest(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	return r.answer(ctx, uid, username, false)
+}
+
+func (r *memProfileRepo) SeesArticles(ctx context.Context, uid, id uint) (bool, error) {
+	defer r.mem.lock(ctx)()
+	return uid == id || !r.mem.users[id].Private || r.mem.following(uid, id), nil
+}
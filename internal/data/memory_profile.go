@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"realworld/internal/biz"
+)
+
+type memProfileRepo struct {
+	mem *memStore
+}
+
+func (r *memProfileRepo) GetProfileById(ctx context.Context, uid uint) (*biz.Profile, error) {
+	defer r.mem.lock(ctx)()
+	u, ok := r.mem.users[uid]
+	if !ok {
+		return nil, errors.NotFound("user", "not found by username")
+	}
+	return r.mem.counted(&biz.Profile{
+		ID:       u.ID,
+		Username: u.Username,
+		Bio:      u.Bio,
+		Image:    u.Image,
+		Email:    u.Email,
+	}), nil
+}
+
+// getByUsername returns the profile of username without the following flag.
+// The caller holds the lock.
+func (r *memProfileRepo) getByUsername(username string) (*biz.Profile, error) {
+	u, ok := r.mem.userByUsername(username)
+	if !ok {
+		return nil, errors.NotFound("user", "not found by username")
+	}
+	return r.mem.counted(&biz.Profile{
+		ID:       u.ID,
+		Username: u.Username,
+		Bio:      u.Bio,
+		Image:    u.Image,
+		Private:  u.Private,
+	}), nil
+}
+
+func (r *memProfileRepo) GetProfile(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	defer r.mem.lock(ctx)()
+	rv, err := r.getByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if uid > 0 {
+		rv.Following = r.mem.following(uid, rv.ID)
+		rv.Blocking, rv.Muting = r.mem.blocking(uid, rv.ID), r.mem.muting(uid, rv.ID)
+		rv.Requested = r.mem.requested(uid, rv.ID)
+	}
+	return rv, nil
+}
+
+func (r *memProfileRepo) ListProfiles(ctx context.Context, viewer uint, ids []uint) ([]*biz.Profile, error) {
+	defer r.mem.lock(ctx)()
+	rv := make([]*biz.Profile, 0, len(ids))
+	for _, id := range ids {
+		u, ok := r.mem.users[id]
+		if !ok {
+			continue
+		}
+		rv = append(rv, r.mem.counted(&biz.Profile{
+			ID:        u.ID,
+			Username:  u.Username,
+			Bio:       u.Bio,
+			Image:     u.Image,
+			Email:     u.Email,
+			Following: viewer > 0 && r.mem.following(viewer, u.ID),
+			Blocking:  viewer > 0 && r.mem.blocking(viewer, u.ID),
+			Muting:    viewer > 0 && r.mem.muting(viewer, u.ID),
+			Private:   u.Private,
+			Requested: viewer > 0 && r.mem.requested(viewer, u.ID),
+		}))
+	}
+	return rv, nil
+}
+
+func (r *memProfileRepo) FollowUser(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	defer r.mem.lock(ctx)()
+	rv, err := r.getByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	k := followKey{UserID: uid, FollowID: rv.ID}
+	if _, ok := r.mem.follows[k]; !ok {
+		r.mem.follows[k] = r.mem.nextID("follows")
+	}
+	rv.Following = true
+	r.mem.counted(rv)
+	return rv, nil
+}
+
+func (r *memProfileRepo) UnFollowUser(ctx context.Context, uid uint, username string) (*biz.Profile, error) {
+	defer r.mem.lock(ctx)()
+	rv, err := r.getByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	delete(r.mem.follows, followKey{UserID: uid, FollowID: rv.ID})
+	delete(r.mem.followRequests, followKey{UserID: uid, FollowID: rv.ID})
+	rv.Following, rv.Requested = false, false
+	r.mem.counted(rv)
+	return rv, nil
+}
+
+// counted sets the counts of p, which the memory driver counts when asked
+// instead of keeping them.
+func (t memTables) counted(p *biz.Profile) *biz.Profile {
+	p.FollowersCount, p.FollowingCount, p.ArticlesCount = 0, 0, 0
+	for k := range t.follows {
+		if k.FollowID == p.ID {
+			p.FollowersCount++
+		}
+		if k.UserID == p.ID {
+			p.FollowingCount++
+		}
+	}
+	for _, a := range t.articles {
+		if a.AuthorID == p.ID && a.Status == string(biz.StatusPublished) {
+			p.ArticlesCount++
+		}
+	}
+	return p
+}
+
+func (r *memProfileRepo) Followers(ctx context.Context, uid uint, limit, offset int64) ([]uint, error) {
+	defer r.mem.lock(ctx)()
+	return followPage(r.mem.follows, limit, offset, func(k followKey) (uint, bool) { return k.UserID, k.FollowID == uid }), nil
+}
+
+func (r *memProfileRepo) Followed(ctx context.Context, uid uint, limit, offset int64) ([]uint, error) {
+	defer r.mem.lock(ctx)()
+	return followPage(r.mem.follows, limit, offset, func(k followKey) (uint, bool) { return k.FollowID, k.UserID == uid }), nil
+}
+
+func (r *memProfileRepo) RecountArticles(ctx context.Context, uid uint) error {
+	return nil
+}
+
+// followPage returns a page of the users of follows that pick keeps, the
+// latest follow first.
+func followPage(follows map[followKey]uint, limit, offset int64, pick func(followKey) (uint, bool)) []uint {
+	var keys []followKey
+	for k := range follows {
+		if _, ok := pick(k); ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return follows[keys[i]] > follows[keys[j]] })
+	var rv []uint
+	for i := offset; i < int64(len(keys)) && i < offset+limit; i++ {
+		id, _ := pick(keys[i])
+		rv = append(rv, id)
+	}
+	return rv
+}
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"realworld/internal/biz"
+)
+
+type memRevisionRepo struct {
+	mem *memStore
+}
+
+func (r *memRevisionRepo) Create(ctx context.Context, in *biz.Revision) (*biz.Revision, error) {
+	defer r.mem.lock(ctx)()
+	var last uint32
+	for _, x := range r.mem.revisions {
+		if x.ArticleID == in.ArticleID && x.Number > last {
+			last = x.Number
+		}
+	}
+	x := ArticleRevision{
+		ID:           r.mem.nextID("article_revisions"),
+		CreatedAt:    time.Now(),
+		ArticleID:    in.ArticleID,
+		Number:       last + 1,
+		Title:        in.Title,
+		Description:  in.Description,
+		Body:         in.Body,
+		TagList:      append([]string{}, in.TagList...),
+		AuthorID:     in.AuthorID,
+		RestoredFrom: in.RestoredFrom,
+	}
+	r.mem.revisions[x.ID] = x
+	return convertRevision(x), nil
+}
+
+func (r *memRevisionRepo) List(ctx context.Context, articleID uint) ([]*biz.Revision, error) {
+	defer r.mem.lock(ctx)()
+	var revisions []ArticleRevision
+	for _, x := range r.mem.revisions {
+		if x.ArticleID == articleID {
+			revisions = append(revisions, x)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i].Number > revisions[j].Number })
+	rv := make([]*biz.Revision, len(revisions))
+	for i, x := range revisions {
+		rv[i] = convertRevision(x)
+	}
+	return rv, nil
+}
+
+func (r *memRevisionRepo) Get(ctx context.Context, articleID uint, number uint32) (*biz.Revision, error) {
+	defer r.mem.lock(ctx)()
+	for _, x := range r.mem.revisions {
+		if x.ArticleID == articleID && x.Number == number {
+			return convertRevision(x), nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
@@ -0,0 +1,93 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"realworld/internal/biz"
+)
+
+type memSeriesRepo struct {
+	mem *memStore
+}
+
+func (r *memSeriesRepo) Create(ctx context.Context, in *biz.Series) (*biz.Series, error) {
+	defer r.mem.lock(ctx)()
+	now := time.Now()
+	x := Series{ID: r.mem.nextID("series"), CreatedAt: now, UpdatedAt: now, AuthorID: in.AuthorID, Title: in.Title}
+	r.mem.series[x.ID] = x
+	return convertSeries(x), nil
+}
+
+func (r *memSeriesRepo) Get(ctx context.Context, id uint) (*biz.Series, error) {
+	defer r.mem.lock(ctx)()
+	x, ok := r.mem.series[id]
+	if !ok {
+		return nil, biz.ErrSeriesNotFound
+	}
+	return convertSeries(x), nil
+}
+
+func (r *memSeriesRepo) Rename(ctx context.Context, id uint, title string) error {
+	defer r.mem.lock(ctx)()
+	if x, ok := r.mem.series[id]; ok {
+		x.Title, x.UpdatedAt = title, time.Now()
+		r.mem.series[id] = x
+	}
+	return nil
+}
+
+func (r *memSeriesRepo) Delete(ctx context.Context, id uint) error {
+	defer r.mem.lock(ctx)()
+	r.mem.clearSeries(id)
+	delete(r.mem.series, id)
+	return nil
+}
+
+func (r *memSeriesRepo) Articles(ctx context.Context, id uint) ([]*biz.Article, error) {
+	defer r.mem.lock(ctx)()
+	rv := make([]*biz.Article, 0)
+	for _, x := range r.mem.seriesOf(id) {
+		if a, ok := r.mem.articles[x.ArticleID]; ok {
+			rv = append(rv, r.mem.convertArticle(a))
+		}
+	}
+	return rv, nil
+}
+
+func (r *memSeriesRepo) SetArticles(ctx context.Context, id uint, articleIDs []uint) error {
+	defer r.mem.lock(ctx)()
+	r.mem.clearSeries(id)
+	for i, aid := range articleIDs {
+		r.mem.seriesArticles[aid] = SeriesArticle{ID: r.mem.nextID("series_articles"), SeriesID: id, ArticleID: aid, Position: uint32(i + 1)}
+	}
+	if x, ok := r.mem.series[id]; ok {
+		x.UpdatedAt = time.Now()
+		r.mem.series[id] = x
+	}
+	return nil
+}
+
+func (r *memSeriesRepo) OfArticle(ctx context.Context, articleID uint) (uint, error) {
+	defer r.mem.lock(ctx)()
+	return r.mem.seriesArticles[articleID].SeriesID, nil
+}
+
+// seriesOf returns the rows of series id in order.
+func (t memTables) seriesOf(id uint) []SeriesArticle {
+	var rv []SeriesArticle
+	for _, x := range t.seriesArticles {
+		if x.SeriesID == id {
+			rv = append(rv, x)
+		}
+	}
+	sort.Slice(rv, func(i, j int) bool { return rv[i].Position < rv[j].Position })
+	return rv
+}
+
+func (t memTables) clearSeries(id uint) {
+	for _, x := range t.seriesOf(id) {
+		delete(t.seriesArticles, x.ArticleID)
+	}
+}
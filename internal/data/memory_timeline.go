@@ -0,0 +1,126 @@
+package data
+
+import (
+	"context"
+	"sort"
+
+	"realworld/internal/biz"
+)
+
+type memTimelineRepo struct {
+	mem         *memStore
+	size        int
+	fanoutLimit int64
+}
+
+// inFeed is inFeed of timelineRepo for article a, oldest being where the
+// feed of uid starts, see feedStart.
+func (t memTables) inFeed(uid uint, a Article, oldest uint) bool {
+	if _, ok := t.timelines[timelineKey{UserID: uid, ArticleID: a.ID}]; ok {
+		return true
+	}
+	return !a.FannedOut && a.ID >= oldest && t.following(uid, a.AuthorID)
+}
+
+// feedStart is the oldest article a full timeline of uid keeps, 0 while it
+// is not full.
+func (t memTables) feedStart(uid uint, size int) uint {
+	var ids []uint
+	for k := range t.timelines {
+		if k.UserID == uid {
+			ids = append(ids, k.ArticleID)
+		}
+	}
+	if len(ids) < size {
+		return 0
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	return ids[size-1]
+}
+
+func (r *memTimelineRepo) Push(ctx context.Context, articleID, authorID uint) error {
+	defer r.mem.lock(ctx)()
+	var followers []uint
+	for k := range r.mem.follows {
+		if k.FollowID == authorID {
+			followers = append(followers, k.UserID)
+		}
+	}
+	a, ok := r.mem.articles[articleID]
+	if int64(len(followers)) > r.fanoutLimit || !ok || a.FannedOut {
+		return nil
+	}
+	a.FannedOut = true
+	r.mem.articles[articleID] = a
+	for _, uid := range followers {
+		r.mem.timelines[timelineKey{UserID: uid, ArticleID: articleID}] = authorID
+	}
+	return nil
+}
+
+func (r *memTimelineRepo) RemoveArticle(ctx context.Context, articleID uint) error {
+	defer r.mem.lock(ctx)()
+	for k := range r.mem.timelines {
+		if k.ArticleID == articleID {
+			delete(r.mem.timelines, k)
+		}
+	}
+	if a, ok := r.mem.articles[articleID]; ok {
+		a.FannedOut = false
+		r.mem.articles[articleID] = a
+	}
+	if a, ok := r.mem.trashedArticles[articleID]; ok {
+		a.FannedOut = false
+		r.mem.trashedArticles[articleID] = a
+	}
+	return nil
+}
+
+func (r *memTimelineRepo) Backfill(ctx context.Context, userID, authorID uint) error {
+	defer r.mem.lock(ctx)()
+	var ids []uint
+	for _, a := range r.mem.articles {
+		if a.AuthorID == authorID && a.Status == string(biz.StatusPublished) && a.FannedOut {
+			if _, ok := r.mem.timelines[timelineKey{UserID: userID, ArticleID: a.ID}]; !ok {
+				ids = append(ids, a.ID)
+			}
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	if len(ids) > r.size {
+		ids = ids[:r.size]
+	}
+	for _, id := range ids {
+		r.mem.timelines[timelineKey{UserID: userID, ArticleID: id}] = authorID
+	}
+	return nil
+}
+
+func (r *memTimelineRepo) RemoveAuthor(ctx context.Context, userID, authorID uint) error {
+	defer r.mem.lock(ctx)()
+	for k, author := range r.mem.timelines {
+		if k.UserID == userID && author == authorID {
+			delete(r.mem.timelines, k)
+		}
+	}
+	return nil
+}
+
+func (r *memTimelineRepo) Trim(ctx context.Context) (n int64, err error) {
+	defer r.mem.lock(ctx)()
+	byUser := make(map[uint][]uint)
+	for k := range r.mem.timelines {
+		byUser[k.UserID] = append(byUser[k.UserID], k.ArticleID)
+	}
+	for uid, ids := range byUser {
+		if len(ids) <= r.size {
+			continue
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+		for _, id := range ids[r.size:] {
+			delete(r.mem.timelines, timelineKey{UserID: uid, ArticleID: id})
+			n++
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,144 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"realworld/internal/biz"
+)
+
+type memTrashRepo struct {
+	mem *memStore
+}
+
+// deletedFirst orders rows by deletion like the trash queries of trashRepo.
+func deletedFirst(a, b gorm.Model) bool {
+	if !a.DeletedAt.Time.Equal(b.DeletedAt.Time) {
+		return a.DeletedAt.Time.After(b.DeletedAt.Time)
+	}
+	return a.ID > b.ID
+}
+
+func (r *memTrashRepo) Articles(ctx context.Context, authorID uint) ([]*biz.Article, error) {
+	defer r.mem.lock(ctx)()
+	var articles []Article
+	for _, a := range r.mem.trashedArticles {
+		if a.AuthorID == authorID {
+			articles = append(articles, a)
+		}
+	}
+	sort.Slice(articles, func(i, j int) bool { return deletedFirst(articles[i].Model, articles[j].Model) })
+	rv := make([]*biz.Article, len(articles))
+	for i, x := range articles {
+		rv[i] = r.mem.convertArticle(x)
+	}
+	return rv, nil
+}
+
+func (r *memTrashRepo) Comments(ctx context.Context, authorID uint) ([]*biz.Comment, error) {
+	defer r.mem.lock(ctx)()
+	var comments []Comment
+	for _, c := range r.mem.trashedComments {
+		if _, ok := r.mem.articles[c.ArticleID]; ok && c.AuthorID == authorID {
+			comments = append(comments, c)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool { return deletedFirst(comments[i].Model, comments[j].Model) })
+	rv := make([]*biz.Comment, len(comments))
+	for i, x := range comments {
+		rv[i] = convertComment(x)
+	}
+	return rv, nil
+}
+
+func (r *memTrashRepo) Article(ctx context.Context, id uint) (*biz.Article, error) {
+	defer r.mem.lock(ctx)()
+	a, ok := r.mem.trashedArticles[id]
+	if !ok {
+		return nil, biz.ErrArticleNotFound
+	}
+	return r.mem.convertArticle(a), nil
+}
+
+func (r *memTrashRepo) Comment(ctx context.Context, id uint) (*biz.Comment, error) {
+	defer r.mem.lock(ctx)()
+	c, ok := r.mem.trashedComments[id]
+	if !ok {
+		return nil, biz.ErrCommentNotFound
+	}
+	return convertComment(c), nil
+}
+
+func (r *memTrashRepo) RestoreArticle(ctx context.Context, id uint) error {
+	defer r.mem.lock(ctx)()
+	a, ok := r.mem.trashedArticles[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	a.DeletedAt = gorm.DeletedAt{}
+	r.mem.articles[id] = a
+	delete(r.mem.trashedArticles, id)
+	if r.mem.articleSlugs[a.Slug] == id {
+		delete(r.mem.articleSlugs, a.Slug)
+	}
+	return nil
+}
+
+func (r *memTrashRepo) RestoreComment(ctx context.Context, id uint) error {
+	defer r.mem.lock(ctx)()
+	c, ok := r.mem.trashedComments[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	c.DeletedAt = gorm.DeletedAt{}
+	r.mem.comments[id] = c
+	delete(r.mem.trashedComments, id)
+	return nil
+}
+
+// Purge leaves the slugs of the purged articles in articleSlugs, like
+// trashRepo.Purge.
+func (r *memTrashRepo) Purge(ctx context.Context, before time.Time) (articles, comments int64, err error) {
+	defer r.mem.lock(ctx)()
+	t := r.mem.memTables
+	for id, a := range t.trashedArticles {
+		if !a.DeletedAt.Time.Before(before) {
+			continue
+		}
+		delete(t.trashedArticles, id)
+		delete(t.articleTags, id)
+		delete(t.seriesArticles, id)
+		for k := range t.favorites {
+			if k.ArticleID == id {
+				delete(t.favorites, k)
+			}
+		}
+		for k := range t.articleAuthors {
+			if k.ArticleID == id {
+				delete(t.articleAuthors, k)
+			}
+		}
+		for k, x := range t.revisions {
+			if x.ArticleID == id {
+				delete(t.revisions, k)
+			}
+		}
+		for _, m := range []map[uint]Comment{t.comments, t.trashedComments} {
+			for k, c := range m {
+				if c.ArticleID == id {
+					delete(m, k)
+				}
+			}
+		}
+		articles++
+	}
+	for id, c := range t.trashedComments {
+		if c.DeletedAt.Time.Before(before) {
+			delete(t.trashedComments, id)
+			comments++
+		}
+	}
+	return articles, comments, nil
+}
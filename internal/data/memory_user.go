@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"realworld/internal/biz"
+)
+
+type memUserRepo struct {
+	mem *memStore
+}
+
+func (r *memUserRepo) Save(ctx context.Context, g *biz.User) (*biz.User, error) {
+	return g, nil
+}
+
+func (r *memUserRepo) Update(ctx context.Context, g *biz.User) (*biz.User, error) {
+	return g, nil
+}
+
+func (r *memUserRepo) FindByID(context.Context, int64) (*biz.User, error) {
+	return nil, nil
+}
+
+func (r *memUserRepo) ListByHello(context.Context, string) ([]*biz.User, error) {
+	return nil, nil
+}
+
+func (r *memUserRepo) ListAll(context.Context) ([]*biz.User, error) {
+	return nil, nil
+}
+
+func convertUser(u User) *biz.User {
+	return &biz.User{
+		Id:           u.ID,
+		Email:        u.Email,
+		Username:     u.Username,
+		Bio:          u.Bio,
+		Image:        u.Image,
+		PasswordHash: u.PasswordHash,
+		Private:      u.Private,
+	}
+}
+
+func (t memTables) userTaken(email, username string, except uint) bool {
+	for _, u := range t.users {
+		if u.ID != except && (email != "" && u.Email == email || username != "" && u.Username == username) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *memUserRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User, error) {
+	defer r.mem.lock(ctx)()
+	for _, u := range r.mem.users {
+		if u.Email == email {
+			return convertUser(u), nil
+		}
+	}
+	return nil, errors.NotFound("user", "not found by email")
+}
+
+func (r *memUserRepo) GetUserById(ctx context.Context, id uint) (*biz.User, error) {
+	defer r.mem.lock(ctx)()
+	u, ok := r.mem.users[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return convertUser(u), nil
+}
+
+func (r *memUserRepo) CreateUser(ctx context.Context, in *biz.User) error {
+	defer r.mem.lock(ctx)()
+	if r.mem.userTaken(in.Email, in.Username, 0) {
+		return gorm.ErrDuplicatedKey
+	}
+	now := time.Now()
+	u := User{
+		Model:        gorm.Model{ID: r.mem.nextID("users"), CreatedAt: now, UpdatedAt: now},
+		Email:        in.Email,
+		Username:     in.Username,
+		Bio:          in.Bio,
+		Image:        in.Image,
+		PasswordHash: in.PasswordHash,
+	}
+	r.mem.users[u.ID] = u
+	in.Id = u.ID
+	return nil
+}
+
+// UpdateUser only changes the non empty fields of in, like gorm's Updates.
+func (r *memUserRepo) UpdateUser(ctx context.Context, id uint, in *biz.UpdateUser) error {
+	defer r.mem.lock(ctx)()
+	u, ok := r.mem.users[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	if r.mem.userTaken(in.Email, in.Username, id) {
+		return gorm.ErrDuplicatedKey
+	}
+	set := func(dst *string, v string) {
+		if v != "" {
+			*dst = v
+		}
+	}
+	set(&u.Email, in.Email)
+	set(&u.Username, in.Username)
+	set(&u.Bio, in.Bio)
+	set(&u.Image, in.Image)
+	set(&u.PasswordHash, in.PasswordHash)
+	if in.Private != nil {
+		u.Private = *in.Private
+	}
+	if in.Private != nil && !u.Private {
+		for k := range r.mem.followRequests {
+			if k.FollowID == id {
+				delete(r.mem.followRequests, k)
+			}
+		}
+	}
+	u.UpdatedAt = time.Now()
+	r.mem.users[id] = u
+	return nil
+}
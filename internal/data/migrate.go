@@ -0,0 +1,349 @@
+package data
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed migrations
+var migrationsFS embed.FS
+
+// MigrationsDir is where the migration sources live, relative to the repo root.
+const MigrationsDir = "internal/data/migrations"
+
+// Dialects lists the sub directories of MigrationsDir, one per database driver.
+var Dialects = []string{"mysql", "postgres", "sqlite"}
+
+// Migration is one versioned schema change, loaded from
+// migrations/<dialect>/<version>_<name>.{up,down}.sql.
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus is a Migration and when it was applied, nil if pending.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// SchemaMigration records an applied migration.
+type SchemaMigration struct {
+	Version   uint   `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"size:200"`
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator loads the embedded migrations for the dialect of db.
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	if db.Dialector.Name() == MemoryDriver {
+		return nil, fmt.Errorf("the %s database driver has no migrations", MemoryDriver)
+	}
+	ms, err := loadMigrations(migrationsFS, path.Join("migrations", db.Dialector.Name()))
+	if err != nil {
+		return nil, err
+	}
+	if len(ms) == 0 {
+		return nil, fmt.Errorf("no migrations for database driver %s", db.Dialector.Name())
+	}
+	return &Migrator{db: db, migrations: ms}, nil
+}
+
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[uint]*Migration)
+	for _, e := range entries {
+		name := e.Name()
+		var up bool
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			up = true
+		case strings.HasSuffix(name, ".down.sql"):
+		default:
+			continue
+		}
+		version, title, err := parseMigrationName(name)
+		if err != nil {
+			return nil, err
+		}
+		b, err := fs.ReadFile(fsys, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: title}
+			byVersion[version] = m
+		} else if m.Name != title {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, m.Name, title)
+		}
+		if up {
+			m.Up = string(b)
+		} else {
+			m.Down = string(b)
+		}
+	}
+	ms := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		ms = append(ms, *m)
+	}
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Version < ms[j].Version })
+	return ms, nil
+}
+
+// parseMigrationName splits "0002_add_slug.up.sql" into 2 and "add_slug".
+func parseMigrationName(name string) (uint, string, error) {
+	base := strings.TrimSuffix(strings.TrimSuffix(name, ".up.sql"), ".down.sql")
+	v, title, ok := strings.Cut(base, "_")
+	if !ok {
+		return 0, "", fmt.Errorf("invalid migration file name: %s", name)
+	}
+	version, err := strconv.ParseUint(v, 10, 32)
+	if err != nil || version == 0 {
+		return 0, "", fmt.Errorf("invalid migration version: %s", name)
+	}
+	return uint(version), title, nil
+}
+
+// splitStatements splits a migration into the statements it is made of.
+// Statements end with a ';' at the end of a line, lines starting with "--"
+// are comments. Not every driver accepts several statements in one Exec.
+func splitStatements(sql string) []string {
+	var (
+		stmts []string
+		cur   strings.Builder
+	)
+	for _, line := range strings.Split(sql, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		cur.WriteString(line)
+		cur.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSpace(cur.String()))
+			cur.Reset()
+		}
+	}
+	if s := strings.TrimSpace(cur.String()); s != "" {
+		stmts = append(stmts, s)
+	}
+	return stmts
+}
+
+func (m *Migrator) latest() uint {
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) find(version uint) (Migration, bool) {
+	for _, x := range m.migrations {
+		if x.Version == version {
+			return x, true
+		}
+	}
+	return Migration{}, false
+}
+
+// applied returns the applied migrations. It only reads, a database without
+// the bookkeeping table has none.
+func (m *Migrator) applied() ([]SchemaMigration, error) {
+	if !m.db.Migrator().HasTable(&SchemaMigration{}) {
+		return nil, nil
+	}
+	var rv []SchemaMigration
+	if err := m.db.Order("version").Find(&rv).Error; err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// Version returns the latest applied migration, 0 for an empty database.
+func (m *Migrator) Version() (uint, error) {
+	if !m.db.Migrator().HasTable(&SchemaMigration{}) {
+		return 0, nil
+	}
+	var rv SchemaMigration
+	res := m.db.Order("version desc").Limit(1).Find(&rv)
+	return rv.Version, res.Error
+}
+
+// SchemaVersionError is returned by CheckVersion for a database that is not
+// at the latest migration of this build.
+type SchemaVersionError struct {
+	Version, Latest uint
+	// Unknown is set for a version this build has no migration for.
+	Unknown bool
+}
+
+func (e *SchemaVersionError) Error() string {
+	if e.Unknown {
+		return fmt.Sprintf("database schema version %d is unknown to this build (latest is %d)", e.Version, e.Latest)
+	}
+	return fmt.Sprintf("database schema version %d is behind %d, run `realworld migrate up`", e.Version, e.Latest)
+}
+
+// CheckVersion returns a *SchemaVersionError unless the database is exactly
+// at the latest migration this build knows about.
+func (m *Migrator) CheckVersion() error {
+	v, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if _, ok := m.find(v); !ok && v != 0 {
+		return &SchemaVersionError{Version: v, Latest: m.latest(), Unknown: true}
+	}
+	if v != m.latest() {
+		return &SchemaVersionError{Version: v, Latest: m.latest()}
+	}
+	return nil
+}
+
+// Up applies all pending migrations in order and returns them. Each runs in
+// a transaction with its version row, which makes it atomic on sqlite and
+// postgres only: MySQL commits every DDL statement implicitly, so a MySQL
+// migration failing halfway keeps its earlier statements and stays pending.
+func (m *Migrator) Up() ([]Migration, error) {
+	if err := m.db.AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, err
+	}
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[uint]bool, len(applied))
+	for _, x := range applied {
+		if _, ok := m.find(x.Version); !ok {
+			return nil, fmt.Errorf("database schema version %d is unknown to this build", x.Version)
+		}
+		done[x.Version] = true
+	}
+	var rv []Migration
+	for _, x := range m.migrations {
+		if done[x.Version] {
+			continue
+		}
+		err := m.db.Transaction(func(tx *gorm.DB) error {
+			for _, stmt := range splitStatements(x.Up) {
+				if err := tx.Exec(stmt).Error; err != nil {
+					return err
+				}
+			}
+			return tx.Create(&SchemaMigration{Version: x.Version, Name: x.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return rv, fmt.Errorf("migration %d_%s: %w", x.Version, x.Name, err)
+		}
+		rv = append(rv, x)
+	}
+	return rv, nil
+}
+
+// Down rolls back the latest applied migration, nil if there is none. Like
+// Up, it is not atomic on MySQL.
+func (m *Migrator) Down() (*Migration, error) {
+	v, err := m.Version()
+	if err != nil || v == 0 {
+		return nil, err
+	}
+	x, ok := m.find(v)
+	if !ok {
+		return nil, fmt.Errorf("database schema version %d is unknown to this build", v)
+	}
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		for _, stmt := range splitStatements(x.Down) {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&SchemaMigration{Version: x.Version}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("migration %d_%s: %w", x.Version, x.Name, err)
+	}
+	return &x, nil
+}
+
+// Status lists every known migration, followed by applied ones this build
+// does not know about.
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+	at := make(map[uint]SchemaMigration, len(applied))
+	for _, x := range applied {
+		at[x.Version] = x
+	}
+	rv := make([]MigrationStatus, 0, len(m.migrations))
+	for _, x := range m.migrations {
+		s := MigrationStatus{Migration: x}
+		if a, ok := at[x.Version]; ok {
+			s.AppliedAt = &a.AppliedAt
+			delete(at, x.Version)
+		}
+		rv = append(rv, s)
+	}
+	for _, a := range applied {
+		if _, ok := at[a.Version]; ok {
+			a := a
+			rv = append(rv, MigrationStatus{Migration: Migration{Version: a.Version, Name: a.Name}, AppliedAt: &a.AppliedAt})
+		}
+	}
+	return rv, nil
+}
+
+// CreateMigration writes empty up and down files for the next version into
+// every dialect directory under dir and returns their paths.
+func CreateMigration(dir, name string) ([]string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.Join(strings.FieldsFunc(name, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	}), "_")
+	if name == "" {
+		return nil, fmt.Errorf("migration name is required")
+	}
+	var next uint = 1
+	for _, d := range Dialects {
+		ms, err := loadMigrations(os.DirFS(dir), d)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if len(ms) > 0 && ms[len(ms)-1].Version >= next {
+			next = ms[len(ms)-1].Version + 1
+		}
+	}
+	var files []string
+	for _, d := range Dialects {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0o755); err != nil {
+			return files, err
+		}
+		for _, direction := range []string{"up", "down"} {
+			fn := filepath.Join(dir, d, fmt.Sprintf("%04d_%s.%s.sql", next, name, direction))
+			if err := os.WriteFile(fn, nil, 0o644); err != nil {
+				return files, err
+			}
+			files = append(files, fn)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,202 @@
+package data
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"realworld/internal/conf"
+
+	"gorm.io/gorm"
+)
+
+// newTestDb opens an empty sqlite database.
+func newTestDb(t *testing.T) *gorm.DB {
+	t.Helper()
+	return newTestDbAt(t, filepath.Join(t.TempDir(), "realworld.db"))
+}
+
+// newTestDbAt opens the sqlite database at dsn.
+func newTestDbAt(t *testing.T, dsn string) *gorm.DB {
+	t.Helper()
+	db, err := OpenDb(&conf.Data{Database: &conf.Data_Database{Driver: "sqlite", Dns: dsn}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newTestMigrator(t *testing.T, db *gorm.DB) *Migrator {
+	t.Helper()
+	m, err := NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// pending lists the versions Status reports as not applied.
+func pending(t *testing.T, m *Migrator) []uint {
+	t.Helper()
+	ss, err := m.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rv []uint
+	for _, s := range ss {
+		if s.AppliedAt == nil {
+			rv = append(rv, s.Version)
+		}
+	}
+	return rv
+}
+
+func TestMigrateRoundTrip(t *testing.T) {
+	db := newTestDb(t)
+	m := newTestMigrator(t, db)
+	all := make([]uint, 0, len(m.migrations))
+	for _, x := range m.migrations {
+		all = append(all, x.Version)
+	}
+
+	if err := m.CheckVersion(); err == nil {
+		t.Error("CheckVersion passed on an empty database")
+	}
+	if got := pending(t, m); !reflect.DeepEqual(got, all) {
+		t.Errorf("pending before up = %v, want %v", got, all)
+	}
+	applied, err := m.Up()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(all) {
+		t.Errorf("up applied %d migrations, want %d", len(applied), len(all))
+	}
+	if got := pending(t, m); got != nil {
+		t.Errorf("pending after up = %v", got)
+	}
+	if err := m.CheckVersion(); err != nil {
+		t.Error(err)
+	}
+	if !db.Migrator().HasTable("users") {
+		t.Error("up did not create users")
+	}
+	if applied, err := m.Up(); err != nil || len(applied) != 0 {
+		t.Errorf("second up = %d, %v", len(applied), err)
+	}
+
+	// 逐个回滚到空库
+	for i := len(all) - 1; i >= 0; i-- {
+		x, err := m.Down()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x == nil || x.Version != all[i] {
+			t.Fatalf("down rolled back %v, want %d", x, all[i])
+		}
+		if got := pending(t, m); !reflect.DeepEqual(got, all[i:]) {
+			t.Errorf("pending after down = %v, want %v", got, all[i:])
+		}
+	}
+	if x, err := m.Down(); x != nil || err != nil {
+		t.Errorf("down on an empty database = %v, %v", x, err)
+	}
+	if db.Migrator().HasTable("users") {
+		t.Error("down left users")
+	}
+	if v, err := m.Version(); v != 0 || err != nil {
+		t.Errorf("version = %d, %v", v, err)
+	}
+}
+
+func TestMigrateUnknownVersion(t *testing.T) {
+	db := newTestDb(t)
+	m := newTestMigrator(t, db)
+	if _, err := m.Up(); err != nil {
+		t.Fatal(err)
+	}
+	// 更新的版本跑过的库
+	if err := db.Create(&SchemaMigration{Version: m.latest() + 1, Name: "future", AppliedAt: time.Now()}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := m.CheckVersion(); err == nil {
+		t.Error("CheckVersion passed on an unknown version")
+	}
+	if _, err := m.Up(); err == nil {
+		t.Error("up passed on an unknown version")
+	}
+	if _, err := m.Down(); err == nil {
+		t.Error("down passed on an unknown version")
+	}
+	ss, err := m.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := ss[len(ss)-1]; last.Version != m.latest()+1 || last.AppliedAt == nil {
+		t.Errorf("status ends with %+v, want the unknown version", last)
+	}
+}
+
+func TestMigrateStatusReadOnly(t *testing.T) {
+	db := newTestDb(t)
+	m := newTestMigrator(t, db)
+	if got := pending(t, m); len(got) != len(m.migrations) {
+		t.Errorf("pending = %v", got)
+	}
+	if err := m.CheckVersion(); err == nil {
+		t.Error("CheckVersion passed on an empty database")
+	}
+	if x, err := m.Down(); x != nil || err != nil {
+		t.Errorf("down on an empty database = %v, %v", x, err)
+	}
+	if db.Migrator().HasTable(&SchemaMigration{}) {
+		t.Error("status created the bookkeeping table")
+	}
+}
+
+func TestMigrateDedupesFollows(t *testing.T) {
+	db := newTestDb(t)
+	m := newTestMigrator(t, db)
+	if _, err := m.Up(); err != nil {
+		t.Fatal(err)
+	}
+	// 回到加唯一索引之前, 那时可能有重复和软删除的关注
+	for v, err := m.Version(); v >= 15; v, err = m.Version() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := m.Down(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	follows := []Follow{{UserId: 1, FollowId: 2}, {UserId: 1, FollowId: 2}, {UserId: 2, FollowId: 1}, {UserId: 1, FollowId: 3}}
+	if err := db.Create(&follows).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Delete(&follows[3]).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(); err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []uint
+	if err := db.Unscoped().Model(&Follow{}).Order("id").Pluck("id", &ids).Error; err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint{follows[0].ID, follows[2].ID}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("follows after up = %v, want %v", ids, want)
+	}
+	if err := db.Create(&Follow{UserId: 2, FollowId: 1}).Error; err == nil {
+		t.Error("inserted a repeated follow")
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	got := splitStatements("-- comment\nCREATE TABLE a (\n  id INT\n);\n\nCREATE INDEX b ON a (id);\nDROP TABLE c")
+	want := []string{"CREATE TABLE a (\n  id INT\n);", "CREATE INDEX b ON a (id);", "DROP TABLE c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitStatements = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,85 @@
+-- Baseline schema. IF NOT EXISTS lets databases created by the old
+-- AutoMigrate-on-boot adopt the versioned migrations unchanged.
+CREATE TABLE IF NOT EXISTS `users` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `email` varchar(500) UNIQUE,
+  `username` varchar(500) UNIQUE,
+  `bio` varchar(1000),
+  `image` varchar(1000),
+  `password_hash` varchar(500),
+  `following` int unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_users_deleted_at` (`deleted_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `follows` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `follow_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_follows_deleted_at` (`deleted_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `articles` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `slug` varchar(200),
+  `title` varchar(200) UNIQUE,
+  `description` varchar(200),
+  `body` longtext,
+  `author_id` bigint unsigned,
+  `favorites_count` int unsigned,
+  `username` varchar(200),
+  `email` varchar(200),
+  `image` varchar(200),
+  PRIMARY KEY (`id`),
+  INDEX `idx_articles_deleted_at` (`deleted_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `tags` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `name` varchar(200),
+  PRIMARY KEY (`id`),
+  INDEX `idx_tags_deleted_at` (`deleted_at`),
+  UNIQUE INDEX `idx_tags_name` (`name`)
+);
+
+CREATE TABLE IF NOT EXISTS `article_tags` (
+  `tag_id` bigint unsigned,
+  `article_id` bigint unsigned,
+  PRIMARY KEY (`tag_id`, `article_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `comments` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `article_slug` varchar(200),
+  `body` longtext,
+  `author_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_comments_deleted_at` (`deleted_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `article_favorites` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `deleted_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `article_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_article_favorites_deleted_at` (`deleted_at`)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE `articles` ADD COLUMN `username` varchar(200), ADD COLUMN `email` varchar(200), ADD COLUMN `image` varchar(200);
+UPDATE `articles` JOIN `users` ON `users`.`id` = `articles`.`author_id`
+  SET `articles`.`username` = `users`.`username`, `articles`.`email` = `users`.`email`, `articles`.`image` = `users`.`image`;
@@ -0,0 +1,2 @@
+-- Article authors are read from users, see biz.ProfileLoader.
+ALTER TABLE `articles` DROP COLUMN `username`, DROP COLUMN `email`, DROP COLUMN `image`;
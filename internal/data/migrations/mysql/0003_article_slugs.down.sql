@@ -0,0 +1,6 @@
+ALTER TABLE `comments` ADD COLUMN `article_slug` varchar(200);
+UPDATE `comments` SET `article_slug` = CAST(`article_id` AS CHAR);
+DROP INDEX `idx_comments_article_id` ON `comments`;
+ALTER TABLE `comments` DROP COLUMN `article_id`;
+DROP TABLE IF EXISTS `article_slugs`;
+DROP INDEX `idx_articles_slug` ON `articles`;
@@ -0,0 +1,25 @@
+-- Articles are addressed by their slug, which must be unique. Duplicates get
+-- their id appended, empty ones become "article-<id>".
+UPDATE `articles` SET `slug` = CONCAT('article-', `id`) WHERE `slug` IS NULL OR `slug` = '';
+UPDATE `articles` SET `slug` = CONCAT(`slug`, '-', `id`)
+  WHERE `slug` IN (SELECT `slug` FROM (SELECT `slug` FROM `articles` GROUP BY `slug` HAVING COUNT(*) > 1) AS `d`);
+CREATE UNIQUE INDEX `idx_articles_slug` ON `articles` (`slug`);
+
+-- Slugs articles were known by before. Old URLs used the id as slug.
+CREATE TABLE `article_slugs` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `article_id` bigint unsigned,
+  `slug` varchar(200),
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_article_slugs_slug` (`slug`),
+  INDEX `idx_article_slugs_article_id` (`article_id`)
+);
+INSERT INTO `article_slugs` (`created_at`, `article_id`, `slug`)
+  SELECT NOW(3), `id`, CAST(`id` AS CHAR) FROM `articles` WHERE `slug` <> CAST(`id` AS CHAR);
+
+-- Comments point at the article, not at a slug that can change.
+ALTER TABLE `comments` ADD COLUMN `article_id` bigint unsigned;
+UPDATE `comments` SET `article_id` = (SELECT `id` FROM `articles` WHERE CAST(`articles`.`id` AS CHAR) = `comments`.`article_slug`);
+ALTER TABLE `comments` DROP COLUMN `article_slug`;
+CREATE INDEX `idx_comments_article_id` ON `comments` (`article_id`);
@@ -0,0 +1,24 @@
+-- Every version of an article, rows are only ever inserted.
+CREATE TABLE `article_revisions` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `article_id` bigint unsigned,
+  `number` int unsigned,
+  `title` varchar(200),
+  `description` varchar(200),
+  `body` longtext,
+  `tag_list` longtext,
+  `author_id` bigint unsigned,
+  `restored_from` int unsigned,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_article_revisions_number` (`article_id`, `number`)
+);
+
+-- The current content of existing articles is their first revision.
+INSERT INTO `article_revisions` (`created_at`, `article_id`, `number`, `title`, `description`, `body`, `tag_list`, `author_id`, `restored_from`)
+  SELECT `updated_at`, `id`, 1, `title`, `description`, `body`,
+    COALESCE((SELECT JSON_ARRAYAGG(`tags`.`name`)
+      FROM `article_tags` JOIN `tags` ON `tags`.`id` = `article_tags`.`tag_id`
+      WHERE `article_tags`.`article_id` = `articles`.`id`), JSON_ARRAY()),
+    `author_id`, 0
+  FROM `articles`;
@@ -0,0 +1,5 @@
+-- Articles are draft, scheduled or published. Existing ones were published
+-- when they were created.
+ALTER TABLE `articles` ADD COLUMN `status` varchar(20) NOT NULL DEFAULT 'published', ADD COLUMN `publish_at` datetime(3) NULL;
+UPDATE `articles` SET `publish_at` = `created_at`;
+CREATE INDEX `idx_articles_status_publish_at` ON `articles` (`status`, `publish_at`);
@@ -0,0 +1 @@
+DROP INDEX `idx_articles_search` ON `articles`;
@@ -0,0 +1,2 @@
+-- Full-text index for the database search engine, see articleSearch.
+CREATE FULLTEXT INDEX `idx_articles_search` ON `articles` (`title`, `description`, `body`);
@@ -0,0 +1 @@
+ALTER TABLE `articles` DROP COLUMN `reading_time`, DROP COLUMN `word_count`, DROP COLUMN `toc`, DROP COLUMN `body_html`;
@@ -0,0 +1,3 @@
+-- The rendered body of articles and what is derived from it. Existing articles
+-- have no body_html and are rendered when they are read.
+ALTER TABLE `articles` ADD COLUMN `body_html` longtext NULL, ADD COLUMN `toc` longtext NULL, ADD COLUMN `word_count` int unsigned NOT NULL DEFAULT 0, ADD COLUMN `reading_time` int unsigned NOT NULL DEFAULT 0;
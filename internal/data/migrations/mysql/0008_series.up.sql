@@ -0,0 +1,21 @@
+-- Series are ordered lists of articles of one user. An article is in one
+-- series at most.
+CREATE TABLE `series` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  `author_id` bigint unsigned,
+  `title` varchar(200),
+  PRIMARY KEY (`id`),
+  INDEX `idx_series_author_id` (`author_id`)
+);
+
+CREATE TABLE `series_articles` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `series_id` bigint unsigned,
+  `article_id` bigint unsigned,
+  `position` int unsigned,
+  PRIMARY KEY (`id`),
+  INDEX `idx_series_articles_series_id` (`series_id`),
+  UNIQUE INDEX `idx_series_articles_article_id` (`article_id`)
+);
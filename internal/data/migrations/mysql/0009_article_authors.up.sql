@@ -0,0 +1,13 @@
+-- Co-authors of articles besides articles.author_id, their owner. Rows with
+-- no accepted_at are pending invitations.
+CREATE TABLE `article_authors` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `article_id` bigint unsigned,
+  `user_id` bigint unsigned,
+  `invited_by` bigint unsigned,
+  `accepted_at` datetime(3) NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_article_authors_article_user` (`article_id`, `user_id`),
+  INDEX `idx_article_authors_user_id` (`user_id`)
+);
@@ -0,0 +1,4 @@
+DROP INDEX `idx_follows_follow_id` ON `follows`;
+DROP INDEX `idx_follows_user_id` ON `follows`;
+ALTER TABLE `articles` DROP COLUMN `fanned_out`;
+DROP TABLE IF EXISTS `timeline_entries`;
@@ -0,0 +1,16 @@
+-- Feeds are materialized: articles are pushed to the timelines of the
+-- followers of their author. Existing articles are not, and the feed reads
+-- them through follows.
+CREATE TABLE `timeline_entries` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `user_id` bigint unsigned,
+  `article_id` bigint unsigned,
+  `author_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_timeline_entries_user_article` (`user_id`, `article_id`),
+  INDEX `idx_timeline_entries_user_author` (`user_id`, `author_id`),
+  INDEX `idx_timeline_entries_article_id` (`article_id`)
+);
+ALTER TABLE `articles` ADD COLUMN `fanned_out` boolean NOT NULL DEFAULT false;
+CREATE INDEX `idx_follows_user_id` ON `follows` (`user_id`);
+CREATE INDEX `idx_follows_follow_id` ON `follows` (`follow_id`);
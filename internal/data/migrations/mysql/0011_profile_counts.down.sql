@@ -0,0 +1 @@
+ALTER TABLE `users` DROP COLUMN `articles_count`, DROP COLUMN `following_count`, DROP COLUMN `followers_count`;
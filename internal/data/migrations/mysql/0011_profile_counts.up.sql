@@ -0,0 +1,7 @@
+-- Users keep how many followers, followed users and published articles they
+-- have, updated as they change instead of counted on each read.
+ALTER TABLE `users` ADD COLUMN `followers_count` int unsigned NOT NULL DEFAULT 0, ADD COLUMN `following_count` int unsigned NOT NULL DEFAULT 0, ADD COLUMN `articles_count` int unsigned NOT NULL DEFAULT 0;
+UPDATE `users` SET
+  `followers_count` = (SELECT COUNT(DISTINCT `user_id`) FROM `follows` WHERE `follows`.`follow_id` = `users`.`id` AND `follows`.`deleted_at` IS NULL),
+  `following_count` = (SELECT COUNT(DISTINCT `follow_id`) FROM `follows` WHERE `follows`.`user_id` = `users`.`id` AND `follows`.`deleted_at` IS NULL),
+  `articles_count` = (SELECT COUNT(*) FROM `articles` WHERE `articles`.`author_id` = `users`.`id` AND `articles`.`status` = 'published' AND `articles`.`deleted_at` IS NULL);
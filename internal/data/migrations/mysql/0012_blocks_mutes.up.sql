@@ -0,0 +1,17 @@
+CREATE TABLE `blocks` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `blocked_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_blocks_user_blocked` (`user_id`, `blocked_id`),
+  INDEX `idx_blocks_blocked_id` (`blocked_id`)
+);
+CREATE TABLE `mutes` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `muted_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_mutes_user_muted` (`user_id`, `muted_id`)
+);
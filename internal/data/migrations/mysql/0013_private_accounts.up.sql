@@ -0,0 +1,10 @@
+-- Private users approve their followers: following them makes a request.
+ALTER TABLE `users` ADD COLUMN `private` boolean NOT NULL DEFAULT false;
+CREATE TABLE `follow_requests` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `created_at` datetime(3) NULL,
+  `user_id` bigint unsigned,
+  `follow_id` bigint unsigned,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_follow_requests_follow_user` (`follow_id`, `user_id`)
+);
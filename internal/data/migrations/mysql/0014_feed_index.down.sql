@@ -0,0 +1 @@
+DROP INDEX `idx_articles_fanned_out_author_id` ON `articles`;
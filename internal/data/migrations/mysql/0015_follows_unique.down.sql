@@ -0,0 +1 @@
+DROP INDEX `idx_follows_user_follow` ON `follows`;
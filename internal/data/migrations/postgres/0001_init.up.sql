@@ -0,0 +1,85 @@
+-- Baseline schema. IF NOT EXISTS lets databases created by the old
+-- AutoMigrate-on-boot adopt the versioned migrations unchanged.
+CREATE TABLE IF NOT EXISTS "users" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "email" varchar(500) UNIQUE,
+  "username" varchar(500) UNIQUE,
+  "bio" varchar(1000),
+  "image" varchar(1000),
+  "password_hash" varchar(500),
+  "following" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_users_deleted_at" ON "users" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "follows" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "follow_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_follows_deleted_at" ON "follows" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "articles" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "slug" varchar(200),
+  "title" varchar(200) UNIQUE,
+  "description" varchar(200),
+  "body" text,
+  "author_id" bigint,
+  "favorites_count" bigint,
+  "username" varchar(200),
+  "email" varchar(200),
+  "image" varchar(200),
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_articles_deleted_at" ON "articles" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "tags" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "name" varchar(200),
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tags_name" ON "tags" ("name");
+CREATE INDEX IF NOT EXISTS "idx_tags_deleted_at" ON "tags" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "article_tags" (
+  "tag_id" bigint,
+  "article_id" bigint,
+  PRIMARY KEY ("tag_id", "article_id")
+);
+
+CREATE TABLE IF NOT EXISTS "comments" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "article_slug" varchar(200),
+  "body" text,
+  "author_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_comments_deleted_at" ON "comments" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "article_favorites" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "deleted_at" timestamptz,
+  "user_id" bigint,
+  "article_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_article_favorites_deleted_at" ON "article_favorites" ("deleted_at");
@@ -0,0 +1,3 @@
+ALTER TABLE "articles" ADD COLUMN "username" varchar(200), ADD COLUMN "email" varchar(200), ADD COLUMN "image" varchar(200);
+UPDATE "articles" SET "username" = "users"."username", "email" = "users"."email", "image" = "users"."image"
+  FROM "users" WHERE "users"."id" = "articles"."author_id";
@@ -0,0 +1,6 @@
+ALTER TABLE "comments" ADD COLUMN "article_slug" varchar(200);
+UPDATE "comments" SET "article_slug" = CAST("article_id" AS TEXT);
+DROP INDEX IF EXISTS "idx_comments_article_id";
+ALTER TABLE "comments" DROP COLUMN "article_id";
+DROP TABLE IF EXISTS "article_slugs";
+DROP INDEX IF EXISTS "idx_articles_slug";
@@ -0,0 +1,24 @@
+-- Every version of an article, rows are only ever inserted.
+CREATE TABLE "article_revisions" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "article_id" bigint,
+  "number" bigint,
+  "title" varchar(200),
+  "description" varchar(200),
+  "body" text,
+  "tag_list" text,
+  "author_id" bigint,
+  "restored_from" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_article_revisions_number" ON "article_revisions" ("article_id", "number");
+
+-- The current content of existing articles is their first revision.
+INSERT INTO "article_revisions" ("created_at", "article_id", "number", "title", "description", "body", "tag_list", "author_id", "restored_from")
+  SELECT "updated_at", "id", 1, "title", "description", "body",
+    COALESCE((SELECT json_agg("tags"."name" ORDER BY "tags"."id")::text
+      FROM "article_tags" JOIN "tags" ON "tags"."id" = "article_tags"."tag_id"
+      WHERE "article_tags"."article_id" = "articles"."id"), '[]'),
+    "author_id", 0
+  FROM "articles";
@@ -0,0 +1,3 @@
+-- Full-text index for the database search engine, see articleSearch. The
+-- expression must stay the same as the one searched.
+CREATE INDEX "idx_articles_search" ON "articles" USING GIN (to_tsvector('simple', coalesce("title", '') || ' ' || coalesce("description", '') || ' ' || coalesce("body", '')));
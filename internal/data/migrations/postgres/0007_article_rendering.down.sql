@@ -0,0 +1,4 @@
+ALTER TABLE "articles" DROP COLUMN "reading_time";
+ALTER TABLE "articles" DROP COLUMN "word_count";
+ALTER TABLE "articles" DROP COLUMN "toc";
+ALTER TABLE "articles" DROP COLUMN "body_html";
@@ -0,0 +1,21 @@
+-- Series are ordered lists of articles of one user. An article is in one
+-- series at most.
+CREATE TABLE "series" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "updated_at" timestamptz,
+  "author_id" bigint,
+  "title" varchar(200),
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_series_author_id" ON "series" ("author_id");
+
+CREATE TABLE "series_articles" (
+  "id" bigserial,
+  "series_id" bigint,
+  "article_id" bigint,
+  "position" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_series_articles_series_id" ON "series_articles" ("series_id");
+CREATE UNIQUE INDEX "idx_series_articles_article_id" ON "series_articles" ("article_id");
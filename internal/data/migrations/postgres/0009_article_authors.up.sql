@@ -0,0 +1,13 @@
+-- Co-authors of articles besides articles.author_id, their owner. Rows with
+-- no accepted_at are pending invitations.
+CREATE TABLE "article_authors" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "article_id" bigint,
+  "user_id" bigint,
+  "invited_by" bigint,
+  "accepted_at" timestamptz,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_article_authors_article_user" ON "article_authors" ("article_id", "user_id");
+CREATE INDEX "idx_article_authors_user_id" ON "article_authors" ("user_id");
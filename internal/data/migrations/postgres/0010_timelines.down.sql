@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_follows_follow_id";
+DROP INDEX IF EXISTS "idx_follows_user_id";
+ALTER TABLE "articles" DROP COLUMN "fanned_out";
+DROP TABLE IF EXISTS "timeline_entries";
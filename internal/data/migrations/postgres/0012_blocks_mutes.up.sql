@@ -0,0 +1,17 @@
+CREATE TABLE "blocks" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "user_id" bigint,
+  "blocked_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_blocks_user_blocked" ON "blocks" ("user_id", "blocked_id");
+CREATE INDEX "idx_blocks_blocked_id" ON "blocks" ("blocked_id");
+CREATE TABLE "mutes" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "user_id" bigint,
+  "muted_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_mutes_user_muted" ON "mutes" ("user_id", "muted_id");
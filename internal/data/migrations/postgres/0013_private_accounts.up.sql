@@ -0,0 +1,10 @@
+-- Private users approve their followers: following them makes a request.
+ALTER TABLE "users" ADD COLUMN "private" boolean NOT NULL DEFAULT false;
+CREATE TABLE "follow_requests" (
+  "id" bigserial,
+  "created_at" timestamptz,
+  "user_id" bigint,
+  "follow_id" bigint,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "idx_follow_requests_follow_user" ON "follow_requests" ("follow_id", "user_id");
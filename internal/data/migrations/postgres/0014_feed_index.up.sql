@@ -0,0 +1,2 @@
+-- The feed looks up the articles that were not pushed to timelines by author.
+CREATE INDEX "idx_articles_fanned_out_author_id" ON "articles" ("fanned_out", "author_id");
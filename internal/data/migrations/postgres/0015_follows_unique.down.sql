@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "idx_follows_user_follow";
@@ -0,0 +1,5 @@
+-- A user follows another at most once. Unfollowing deletes the row for good,
+-- so the soft deleted rows go, and of the repeated rows the first one stays.
+DELETE FROM "follows" WHERE "deleted_at" IS NOT NULL;
+DELETE FROM "follows" "f" USING "follows" "g" WHERE "g"."user_id" = "f"."user_id" AND "g"."follow_id" = "f"."follow_id" AND "g"."id" < "f"."id";
+CREATE UNIQUE INDEX "idx_follows_user_follow" ON "follows" ("user_id", "follow_id");
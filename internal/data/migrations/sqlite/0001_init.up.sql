@@ -0,0 +1,79 @@
+-- Baseline schema. IF NOT EXISTS lets databases created by the old
+-- AutoMigrate-on-boot adopt the versioned migrations unchanged.
+CREATE TABLE IF NOT EXISTS `users` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `email` text UNIQUE,
+  `username` text UNIQUE,
+  `bio` text,
+  `image` text,
+  `password_hash` text,
+  `following` integer
+);
+CREATE INDEX IF NOT EXISTS `idx_users_deleted_at` ON `users` (`deleted_at`);
+
+CREATE TABLE IF NOT EXISTS `follows` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `follow_id` integer
+);
+CREATE INDEX IF NOT EXISTS `idx_follows_deleted_at` ON `follows` (`deleted_at`);
+
+CREATE TABLE IF NOT EXISTS `articles` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `slug` text,
+  `title` text UNIQUE,
+  `description` text,
+  `body` text,
+  `author_id` integer,
+  `favorites_count` integer,
+  `username` text,
+  `email` text,
+  `image` text
+);
+CREATE INDEX IF NOT EXISTS `idx_articles_deleted_at` ON `articles` (`deleted_at`);
+
+CREATE TABLE IF NOT EXISTS `tags` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `name` text
+);
+CREATE INDEX IF NOT EXISTS `idx_tags_deleted_at` ON `tags` (`deleted_at`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_tags_name` ON `tags` (`name`);
+
+CREATE TABLE IF NOT EXISTS `article_tags` (
+  `tag_id` integer,
+  `article_id` integer,
+  PRIMARY KEY (`tag_id`, `article_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `comments` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `article_slug` text,
+  `body` text,
+  `author_id` integer
+);
+CREATE INDEX IF NOT EXISTS `idx_comments_deleted_at` ON `comments` (`deleted_at`);
+
+CREATE TABLE IF NOT EXISTS `article_favorites` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `deleted_at` datetime,
+  `user_id` integer,
+  `article_id` integer
+);
+CREATE INDEX IF NOT EXISTS `idx_article_favorites_deleted_at` ON `article_favorites` (`deleted_at`);
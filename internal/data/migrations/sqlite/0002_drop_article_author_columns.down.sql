@@ -0,0 +1,7 @@
+ALTER TABLE `articles` ADD COLUMN `username` text;
+ALTER TABLE `articles` ADD COLUMN `email` text;
+ALTER TABLE `articles` ADD COLUMN `image` text;
+UPDATE `articles` SET
+  `username` = (SELECT `username` FROM `users` WHERE `users`.`id` = `articles`.`author_id`),
+  `email` = (SELECT `email` FROM `users` WHERE `users`.`id` = `articles`.`author_id`),
+  `image` = (SELECT `image` FROM `users` WHERE `users`.`id` = `articles`.`author_id`);
@@ -0,0 +1,4 @@
+-- Article authors are read from users, see biz.ProfileLoader.
+ALTER TABLE `articles` DROP COLUMN `username`;
+ALTER TABLE `articles` DROP COLUMN `email`;
+ALTER TABLE `articles` DROP COLUMN `image`;
@@ -0,0 +1,24 @@
+-- Articles are addressed by their slug, which must be unique. Duplicates get
+-- their id appended, empty ones become "article-<id>".
+UPDATE `articles` SET `slug` = 'article-' || `id` WHERE `slug` IS NULL OR `slug` = '';
+UPDATE `articles` SET `slug` = `slug` || '-' || `id`
+  WHERE `slug` IN (SELECT `slug` FROM `articles` GROUP BY `slug` HAVING COUNT(*) > 1);
+CREATE UNIQUE INDEX `idx_articles_slug` ON `articles` (`slug`);
+
+-- Slugs articles were known by before. Old URLs used the id as slug.
+CREATE TABLE `article_slugs` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `article_id` integer,
+  `slug` text
+);
+CREATE UNIQUE INDEX `idx_article_slugs_slug` ON `article_slugs` (`slug`);
+CREATE INDEX `idx_article_slugs_article_id` ON `article_slugs` (`article_id`);
+INSERT INTO `article_slugs` (`created_at`, `article_id`, `slug`)
+  SELECT CURRENT_TIMESTAMP, `id`, CAST(`id` AS TEXT) FROM `articles` WHERE `slug` <> CAST(`id` AS TEXT);
+
+-- Comments point at the article, not at a slug that can change.
+ALTER TABLE `comments` ADD COLUMN `article_id` integer;
+UPDATE `comments` SET `article_id` = (SELECT `id` FROM `articles` WHERE CAST(`articles`.`id` AS TEXT) = `comments`.`article_slug`);
+ALTER TABLE `comments` DROP COLUMN `article_slug`;
+CREATE INDEX `idx_comments_article_id` ON `comments` (`article_id`);
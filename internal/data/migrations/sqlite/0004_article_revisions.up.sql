@@ -0,0 +1,23 @@
+-- Every version of an article, rows are only ever inserted.
+CREATE TABLE `article_revisions` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `article_id` integer,
+  `number` integer,
+  `title` text,
+  `description` text,
+  `body` text,
+  `tag_list` text,
+  `author_id` integer,
+  `restored_from` integer
+);
+CREATE UNIQUE INDEX `idx_article_revisions_number` ON `article_revisions` (`article_id`, `number`);
+
+-- The current content of existing articles is their first revision.
+INSERT INTO `article_revisions` (`created_at`, `article_id`, `number`, `title`, `description`, `body`, `tag_list`, `author_id`, `restored_from`)
+  SELECT `updated_at`, `id`, 1, `title`, `description`, `body`,
+    (SELECT json_group_array(`name`) FROM (
+      SELECT `tags`.`name` FROM `article_tags` JOIN `tags` ON `tags`.`id` = `article_tags`.`tag_id`
+      WHERE `article_tags`.`article_id` = `articles`.`id` ORDER BY `tags`.`id`)),
+    `author_id`, 0
+  FROM `articles`;
@@ -0,0 +1,6 @@
+-- Articles are draft, scheduled or published. Existing ones were published
+-- when they were created.
+ALTER TABLE `articles` ADD COLUMN `status` text NOT NULL DEFAULT 'published';
+ALTER TABLE `articles` ADD COLUMN `publish_at` datetime;
+UPDATE `articles` SET `publish_at` = `created_at`;
+CREATE INDEX `idx_articles_status_publish_at` ON `articles` (`status`, `publish_at`);
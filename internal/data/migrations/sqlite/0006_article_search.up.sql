@@ -0,0 +1,2 @@
+-- The database search engine scans articles with LIKE on sqlite, the FTS5
+-- module is not built into the driver. Nothing to index.
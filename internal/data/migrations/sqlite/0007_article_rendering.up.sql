@@ -0,0 +1,6 @@
+-- The rendered body of articles and what is derived from it. Existing articles
+-- have no body_html and are rendered when they are read.
+ALTER TABLE `articles` ADD COLUMN `body_html` text;
+ALTER TABLE `articles` ADD COLUMN `toc` text;
+ALTER TABLE `articles` ADD COLUMN `word_count` integer NOT NULL DEFAULT 0;
+ALTER TABLE `articles` ADD COLUMN `reading_time` integer NOT NULL DEFAULT 0;
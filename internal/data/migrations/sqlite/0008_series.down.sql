@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `series_articles`;
+DROP TABLE IF EXISTS `series`;
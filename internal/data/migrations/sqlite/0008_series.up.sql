@@ -0,0 +1,19 @@
+-- Series are ordered lists of articles of one user. An article is in one
+-- series at most.
+CREATE TABLE `series` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `author_id` integer,
+  `title` text
+);
+CREATE INDEX `idx_series_author_id` ON `series` (`author_id`);
+
+CREATE TABLE `series_articles` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `series_id` integer,
+  `article_id` integer,
+  `position` integer
+);
+CREATE INDEX `idx_series_articles_series_id` ON `series_articles` (`series_id`);
+CREATE UNIQUE INDEX `idx_series_articles_article_id` ON `series_articles` (`article_id`);
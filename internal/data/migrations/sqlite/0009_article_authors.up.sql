@@ -0,0 +1,12 @@
+-- Co-authors of articles besides articles.author_id, their owner. Rows with
+-- no accepted_at are pending invitations.
+CREATE TABLE `article_authors` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `article_id` integer,
+  `user_id` integer,
+  `invited_by` integer,
+  `accepted_at` datetime
+);
+CREATE UNIQUE INDEX `idx_article_authors_article_user` ON `article_authors` (`article_id`, `user_id`);
+CREATE INDEX `idx_article_authors_user_id` ON `article_authors` (`user_id`);
@@ -0,0 +1,15 @@
+-- Feeds are materialized: articles are pushed to the timelines of the
+-- followers of their author. Existing articles are not, and the feed reads
+-- them through follows.
+CREATE TABLE `timeline_entries` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `user_id` integer,
+  `article_id` integer,
+  `author_id` integer
+);
+CREATE UNIQUE INDEX `idx_timeline_entries_user_article` ON `timeline_entries` (`user_id`, `article_id`);
+CREATE INDEX `idx_timeline_entries_user_author` ON `timeline_entries` (`user_id`, `author_id`);
+CREATE INDEX `idx_timeline_entries_article_id` ON `timeline_entries` (`article_id`);
+ALTER TABLE `articles` ADD COLUMN `fanned_out` numeric NOT NULL DEFAULT false;
+CREATE INDEX `idx_follows_user_id` ON `follows` (`user_id`);
+CREATE INDEX `idx_follows_follow_id` ON `follows` (`follow_id`);
@@ -0,0 +1,15 @@
+CREATE TABLE `blocks` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `user_id` integer,
+  `blocked_id` integer
+);
+CREATE UNIQUE INDEX `idx_blocks_user_blocked` ON `blocks` (`user_id`, `blocked_id`);
+CREATE INDEX `idx_blocks_blocked_id` ON `blocks` (`blocked_id`);
+CREATE TABLE `mutes` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `user_id` integer,
+  `muted_id` integer
+);
+CREATE UNIQUE INDEX `idx_mutes_user_muted` ON `mutes` (`user_id`, `muted_id`);
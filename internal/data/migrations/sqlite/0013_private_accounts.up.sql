@@ -0,0 +1,9 @@
+-- Private users approve their followers: following them makes a request.
+ALTER TABLE `users` ADD COLUMN `private` numeric NOT NULL DEFAULT false;
+CREATE TABLE `follow_requests` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `created_at` datetime,
+  `user_id` integer,
+  `follow_id` integer
+);
+CREATE UNIQUE INDEX `idx_follow_requests_follow_user` ON `follow_requests` (`follow_id`, `user_id`);
@@ -0,0 +1,5 @@
+-- A user follows another at most once. Unfollowing deletes the row for good,
+-- so the soft deleted rows go, and of the repeated rows the first one stays.
+DELETE FROM `follows` WHERE `deleted_at` IS NOT NULL;
+DELETE FROM `follows` WHERE `id` NOT IN (SELECT MIN(`id`) FROM `follows` GROUP BY `user_id`, `follow_id`);
+CREATE UNIQUE INDEX `idx_follows_user_follow` ON `follows` (`user_id`, `follow_id`);
@@ -5,6 +5,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"realworld/internal/biz"
 )
 
@@ -14,21 +15,30 @@ type ProfileRepo struct {
 }
 
 func NewProfileRepo(data *Data, logger log.Logger) biz.ProfileRepo {
+	if data.mem != nil {
+		return &memProfileRepo{mem: data.mem}
+	}
 	return &ProfileRepo{
 		data: data,
 		log:  log.NewHelper(logger),
 	}
 }
 
+// Follow is unique per pair of users. Unfollowing deletes the row for good,
+// DeletedAt stays unset.
 type Follow struct {
 	gorm.Model
-	UserId   uint
-	FollowId uint
+	UserId   uint `gorm:"uniqueIndex:idx_follows_user_follow"`
+	FollowId uint `gorm:"uniqueIndex:idx_follows_user_follow"`
 }
 
 func (r *ProfileRepo) GetProfileById(ctx context.Context, uid uint) (rv *biz.Profile, err error) {
+	rv = new(biz.Profile)
+	if r.data.cache.get(ctx, profileIdCacheKey(uid), rv) {
+		return rv, nil
+	}
 	u := new(User)
-	res := r.data.db.Where("id = ? ", uid).First(u)
+	res := r.data.DB(ctx).Where("id = ? ", uid).First(u)
 	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		return nil, errors.NotFound("user", "not found by username")
 	}
@@ -36,17 +46,29 @@ func (r *ProfileRepo) GetProfileById(ctx context.Context, uid uint) (rv *biz.Pro
 		return nil, res.Error
 	}
 
-	return &biz.Profile{
-		Username: u.Username,
-		Bio:      u.Bio,
-		Image:    u.Image,
-		Email:    u.Email,
-	}, nil
+	rv = &biz.Profile{
+		ID:             u.ID,
+		Username:       u.Username,
+		Bio:            u.Bio,
+		Image:          u.Image,
+		Email:          u.Email,
+		FollowersCount: u.FollowersCount,
+		FollowingCount: u.FollowingCount,
+		ArticlesCount:  u.ArticlesCount,
+		Private:        u.Private,
+	}
+	r.data.cache.set(ctx, profileIdCacheKey(uid), rv)
+	return rv, nil
 }
 
-func (r *ProfileRepo) GetProfile(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+// getByUsername returns the profile of username without the following flag.
+func (r *ProfileRepo) getByUsername(ctx context.Context, username string) (rv *biz.Profile, err error) {
+	rv = new(biz.Profile)
+	if r.data.cache.get(ctx, profileCacheKey(username), rv) {
+		return rv, nil
+	}
 	u := new(User)
-	res := r.data.db.Where("username = ? ", username).First(u)
+	res := r.data.DB(ctx).Where("username = ? ", username).First(u)
 	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		return nil, errors.NotFound("user", "not found by username")
 	}
@@ -54,60 +76,198 @@ func (r *ProfileRepo) GetProfile(ctx context.Context, uid uint, username string)
 		return nil, res.Error
 	}
 
-	following := false
+	rv = &biz.Profile{
+		ID:             u.ID,
+		Username:       u.Username,
+		Bio:            u.Bio,
+		Image:          u.Image,
+		FollowersCount: u.FollowersCount,
+		FollowingCount: u.FollowingCount,
+		ArticlesCount:  u.ArticlesCount,
+		Private:        u.Private,
+	}
+	r.data.cache.set(ctx, profileCacheKey(username), rv)
+	return rv, nil
+}
+
+func (r *ProfileRepo) isFollowing(ctx context.Context, uid uint, followId uint) bool {
+	var following bool
+	if r.data.cache.get(ctx, followCacheKey(uid, followId), &following) {
+		return following
+	}
+	err := r.data.DB(ctx).Where(&Follow{UserId: uid, FollowId: followId}).First(&Follow{}).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false
+	}
+	following = err == nil
+	r.data.cache.set(ctx, followCacheKey(uid, followId), following)
+	return following
+}
+
+func (r *ProfileRepo) GetProfile(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
 	if uid > 0 {
-		err = r.data.db.Where("user_id = ? ", uid).Where("follow_id", u.ID).First(&Follow{}).Error
-		if err == nil {
-			following = true
+		rv.Following = r.isFollowing(ctx, uid, rv.ID)
+		blocking, muting, err := r.relations(ctx, uid, []uint{rv.ID})
+		if err != nil {
+			return nil, err
+		}
+		rv.Blocking, rv.Muting = blocking[rv.ID], muting[rv.ID]
+		if rv.Private && !rv.Following {
+			requested, err := r.requested(ctx, uid, []uint{rv.ID})
+			if err != nil {
+				return nil, err
+			}
+			rv.Requested = requested[rv.ID]
 		}
 	}
-	return &biz.Profile{
-		Username:  u.Username,
-		Bio:       u.Bio,
-		Image:     u.Image,
-		Following: following,
-	}, nil
+	return rv, nil
 }
 
-func (r *ProfileRepo) FollowUser(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
-	u := new(User)
-	res := r.data.db.Where("username = ? ", username).First(u)
-	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
-		return nil, errors.NotFound("user", "not found by username")
+func (r *ProfileRepo) ListProfiles(ctx context.Context, viewer uint, ids []uint) (rv []*biz.Profile, err error) {
+	if len(ids) == 0 {
+		return nil, nil
 	}
-	if res.Error != nil {
-		return nil, res.Error
+	var users []User
+	if err := r.data.DB(ctx).Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	following, blocking, muting, requested := make(map[uint]bool), make(map[uint]bool), make(map[uint]bool), make(map[uint]bool)
+	if viewer > 0 {
+		if blocking, muting, err = r.relations(ctx, viewer, ids); err != nil {
+			return nil, err
+		}
+		if requested, err = r.requested(ctx, viewer, ids); err != nil {
+			return nil, err
+		}
+		var fids []uint
+		err := r.data.DB(ctx).Model(&Follow{}).Where("user_id = ? AND follow_id IN ?", viewer, ids).Pluck("follow_id", &fids).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range fids {
+			following[id] = true
+		}
+	}
+	rv = make([]*biz.Profile, len(users))
+	for i, u := range users {
+		rv[i] = &biz.Profile{
+			ID:             u.ID,
+			Username:       u.Username,
+			Bio:            u.Bio,
+			Image:          u.Image,
+			Email:          u.Email,
+			Following:      following[u.ID],
+			Blocking:       blocking[u.ID],
+			Muting:         muting[u.ID],
+			Private:        u.Private,
+			Requested:      requested[u.ID],
+			FollowersCount: u.FollowersCount,
+			FollowingCount: u.FollowingCount,
+			ArticlesCount:  u.ArticlesCount,
+		}
 	}
+	return rv, nil
+}
 
-	r.data.db.Save(&Follow{
-		UserId:   uid,
-		FollowId: u.ID,
+func (r *ProfileRepo) FollowUser(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	err = r.data.ExecTx(ctx, func(ctx context.Context) error {
+		// 已经关注过就不插入, 也不计数
+		res := r.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&Follow{UserId: uid, FollowId: rv.ID})
+		if res.Error != nil || res.RowsAffected != 1 {
+			return res.Error
+		}
+		rv.FollowersCount++
+		return r.countFollow(ctx, uid, rv.ID, 1)
 	})
+	if err != nil {
+		return nil, err
+	}
+	r.data.cache.del(ctx, followCacheKey(uid, rv.ID))
 
-	return &biz.Profile{
-		Username:  u.Username,
-		Bio:       u.Bio,
-		Image:     u.Image,
-		Following: true,
-	}, nil
+	rv.Following = true
+	return rv, nil
 }
 
 func (r *ProfileRepo) UnFollowUser(ctx context.Context, uid uint, username string) (rv *biz.Profile, err error) {
-	u := new(User)
-	res := r.data.db.Where("username = ? ", username).First(u)
-	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
-		return nil, errors.NotFound("user", "not found by username")
+	rv, err = r.getByUsername(ctx, username)
+	if err != nil {
+		return nil, err
 	}
-	if res.Error != nil {
-		return nil, res.Error
+	err = r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Where("user_id = ? AND follow_id = ?", uid, rv.ID).Delete(&FollowRequest{}).Error; err != nil {
+			return err
+		}
+		res := r.data.DB(ctx).Unscoped().Where(&Follow{UserId: uid, FollowId: rv.ID}).Delete(&Follow{})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		rv.FollowersCount--
+		return r.countFollow(ctx, uid, rv.ID, -1)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.data.cache.del(ctx, followCacheKey(uid, rv.ID))
+
+	rv.Following, rv.Requested = false, false
+	return rv, nil
+}
+
+// countFollow adds n to the following count of uid and the followers count of
+// followID.
+func (r *ProfileRepo) countFollow(ctx context.Context, uid, followID uint, n int) error {
+	if err := r.data.DB(ctx).Model(&User{}).Where("id = ?", uid).
+		UpdateColumn("following_count", gorm.Expr("following_count + ?", n)).Error; err != nil {
+		return err
+	}
+	if err := r.data.DB(ctx).Model(&User{}).Where("id = ?", followID).
+		UpdateColumn("followers_count", gorm.Expr("followers_count + ?", n)).Error; err != nil {
+		return err
+	}
+	return r.uncache(ctx, uid, followID)
+}
+
+// uncache drops the cached profiles of users ids.
+func (r *ProfileRepo) uncache(ctx context.Context, ids ...uint) error {
+	var users []User
+	if err := r.data.DB(ctx).Select("id", "username").Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return err
 	}
+	keys := make([]string, 0, 2*len(users))
+	for _, u := range users {
+		keys = append(keys, profileCacheKey(u.Username), profileIdCacheKey(u.ID))
+	}
+	r.data.cache.del(ctx, keys...)
+	return nil
+}
 
-	r.data.db.Where("user_id", uid).Where("follow_id", u.ID).Delete(&Follow{})
+func (r *ProfileRepo) Followers(ctx context.Context, uid uint, limit, offset int64) (ids []uint, err error) {
+	// 旧数据里可能有重复的关注
+	err = r.data.DB(ctx).Model(&Follow{}).Where("follow_id = ?", uid).Group("user_id").
+		Order("MAX(id) DESC").Offset(int(offset)).Limit(int(limit)).Pluck("user_id", &ids).Error
+	return ids, err
+}
 
-	return &biz.Profile{
-		Username:  u.Username,
-		Bio:       u.Bio,
-		Image:     u.Image,
-		Following: false,
-	}, nil
+func (r *ProfileRepo) Followed(ctx context.Context, uid uint, limit, offset int64) (ids []uint, err error) {
+	err = r.data.DB(ctx).Model(&Follow{}).Where("user_id = ?", uid).Group("follow_id").
+		Order("MAX(id) DESC").Offset(int(offset)).Limit(int(limit)).Pluck("follow_id", &ids).Error
+	return ids, err
+}
+
+func (r *ProfileRepo) RecountArticles(ctx context.Context, uid uint) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		count := r.data.DB(ctx).Model(&Article{}).Where("author_id = ? AND status = ?", uid, biz.StatusPublished).Select("COUNT(*)")
+		if err := r.data.DB(ctx).Model(&User{}).Where("id = ?", uid).UpdateColumn("articles_count", count).Error; err != nil {
+			return err
+		}
+		return r.uncache(ctx, uid)
+	})
 }
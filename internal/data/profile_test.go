@@ -0,0 +1,99 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestFollowCounts(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			pr := NewProfileRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			counts := func(following, followers uint32) {
+				t.Helper()
+				a, err := pr.GetProfileById(ctx, alice)
+				if err != nil {
+					t.Fatal(err)
+				}
+				b, err := pr.GetProfileById(ctx, bob)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if a.FollowingCount != following || b.FollowersCount != followers {
+					t.Errorf("alice follows %d, bob has %d followers, want %d and %d",
+						a.FollowingCount, b.FollowersCount, following, followers)
+				}
+			}
+
+			for i := 0; i < 2; i++ {
+				p, err := pr.FollowUser(ctx, alice, "bob")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !p.Following || p.FollowersCount != 1 {
+					t.Errorf("follow %d: following %v, followers %d", i, p.Following, p.FollowersCount)
+				}
+				counts(1, 1)
+			}
+			for i := 0; i < 2; i++ {
+				p, err := pr.UnFollowUser(ctx, alice, "bob")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if p.Following || p.FollowersCount != 0 {
+					t.Errorf("unfollow %d: following %v, followers %d", i, p.Following, p.FollowersCount)
+				}
+				counts(0, 0)
+			}
+			// 取关后可以再关注
+			if _, err := pr.FollowUser(ctx, alice, "bob"); err != nil {
+				t.Fatal(err)
+			}
+			counts(1, 1)
+		})
+	}
+}
+
+func TestFollowRace(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			pr := NewProfileRepo(d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := pr.FollowUser(ctx, alice, "bob"); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+			p, err := pr.GetProfileById(ctx, bob)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.FollowersCount != 1 {
+				t.Errorf("bob has %d followers after concurrent follows, want 1", p.FollowersCount)
+			}
+			if d.mem != nil {
+				return
+			}
+			var n int64
+			if err := d.db.Model(&Follow{}).Where("user_id = ? AND follow_id = ?", alice, bob).Count(&n).Error; err != nil || n != 1 {
+				t.Errorf("%d follow rows, %v", n, err)
+			}
+			if err := d.db.Create(&Follow{UserId: alice, FollowId: bob}).Error; err == nil {
+				t.Error("inserted a repeated follow")
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package data
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"realworld/internal/conf"
+	"realworld/pkg/middleware/auth"
+
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+const defaultStickyWindow = 5 * time.Second
+
+// useReplicas sends the reads of db to the replicas of c, writes and
+// transactions keep going to the primary.
+func useReplicas(db *gorm.DB, c *conf.Data_Database) error {
+	replicas := make([]gorm.Dialector, 0, len(c.Replicas))
+	for _, dsn := range c.Replicas {
+		dialector, err := openDialector(c.Driver, dsn, c.Options)
+		if err != nil {
+			return err
+		}
+		replicas = append(replicas, dialector)
+	}
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   dbresolver.RandomPolicy{},
+	})
+	if c.MaxOpenConns > 0 {
+		resolver.SetMaxOpenConns(int(c.MaxOpenConns))
+	}
+	if c.MaxIdleConns > 0 {
+		resolver.SetMaxIdleConns(int(c.MaxIdleConns))
+	}
+	if c.ConnMaxLifetime != nil {
+		resolver.SetConnMaxLifetime(c.ConnMaxLifetime.AsDuration())
+	}
+	return db.Use(resolver)
+}
+
+// stickyUsers remembers who wrote recently, so that their reads go to the
+// primary until the replicas have caught up with their writes. The state is
+// per process: behind a load balancer, route a user to the same instance for
+// at least the window.
+type stickyUsers struct {
+	window time.Duration
+	mu     sync.Mutex
+	until  map[uint]time.Time
+}
+
+func newStickyUsers(window time.Duration) *stickyUsers {
+	if window <= 0 {
+		window = defaultStickyWindow
+	}
+	return &stickyUsers{window: window, until: make(map[uint]time.Time)}
+}
+
+// register marks the user of the statement context after every write db runs.
+func (s *stickyUsers) register(db *gorm.DB) error {
+	mark := func(tx *gorm.DB) {
+		if tx.Error == nil && tx.Statement.Context != nil {
+			s.mark(tx.Statement.Context)
+		}
+	}
+	cb := db.Callback()
+	if err := cb.Create().After("gorm:create").Register("realworld:sticky_create", mark); err != nil {
+		return err
+	}
+	if err := cb.Update().After("gorm:update").Register("realworld:sticky_update", mark); err != nil {
+		return err
+	}
+	if err := cb.Delete().After("gorm:delete").Register("realworld:sticky_delete", mark); err != nil {
+		return err
+	}
+	return cb.Raw().After("gorm:raw").Register("realworld:sticky_raw", mark)
+}
+
+func (s *stickyUsers) mark(ctx context.Context) {
+	uid := auth.GetUserIdOrNotLogin(ctx)
+	if uid == 0 {
+		return
+	}
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.until[uid] = now.Add(s.window)
+	// 顺便清理过期的用户
+	for id, t := range s.until {
+		if t.Before(now) {
+			delete(s.until, id)
+		}
+	}
+}
+
+// stuck reports whether the user of ctx wrote within the window.
+func (s *stickyUsers) stuck(ctx context.Context) bool {
+	if s == nil {
+		return false
+	}
+	uid := auth.GetUserIdOrNotLogin(ctx)
+	if uid == 0 {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return time.Now().Before(s.until[uid])
+}
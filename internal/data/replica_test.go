@@ -0,0 +1,100 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"realworld/internal/conf"
+	"realworld/pkg/middleware/auth"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/gorm"
+)
+
+func TestStickyUsers(t *testing.T) {
+	s := newStickyUsers(50 * time.Millisecond)
+	alice := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 1})
+	bob := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 2})
+
+	if s.stuck(alice) {
+		t.Error("alice stuck before writing")
+	}
+	s.mark(alice)
+	s.mark(context.Background())
+	if !s.stuck(alice) {
+		t.Error("alice not stuck right after writing")
+	}
+	if s.stuck(bob) || s.stuck(context.Background()) {
+		t.Error("the write of alice made others stuck")
+	}
+	time.Sleep(60 * time.Millisecond)
+	if s.stuck(alice) {
+		t.Error("alice still stuck after the window")
+	}
+	var none *stickyUsers
+	if none.stuck(alice) {
+		t.Error("stuck without replicas")
+	}
+	if got := newStickyUsers(0).window; got != defaultStickyWindow {
+		t.Errorf("default window = %v, want %v", got, defaultStickyWindow)
+	}
+}
+
+// TestReplicaReads runs on two sqlite files, the replica never catching up
+// with the primary.
+func TestReplicaReads(t *testing.T) {
+	dir := t.TempDir()
+	c := &conf.Data{Database: &conf.Data_Database{
+		Driver:       "sqlite",
+		Dns:          filepath.Join(dir, "primary.db"),
+		Replicas:     []string{filepath.Join(dir, "replica.db")},
+		StickyWindow: durationpb.New(100 * time.Millisecond),
+	}}
+	for _, dsn := range []string{c.Database.Dns, c.Database.Replicas[0]} {
+		m := newTestMigrator(t, newTestDbAt(t, dsn))
+		if _, err := m.Up(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d, cleanup, err := NewData(NewDb(c, testLogger), c, testLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	alice := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 1})
+	bob := auth.WithContext(context.Background(), &auth.CurrentUser{UserID: 2})
+	if err := d.DB(alice).Create(&Tag{Name: "go"}).Error; err != nil {
+		t.Fatal(err)
+	}
+	onPrimary := func(ctx context.Context) bool {
+		t.Helper()
+		err := d.DB(ctx).Where("name = ?", "go").First(&Tag{}).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Fatal(err)
+		}
+		return err == nil
+	}
+	if !onPrimary(alice) {
+		t.Error("alice read a replica right after writing")
+	}
+	if onPrimary(bob) || onPrimary(context.Background()) {
+		t.Error("others read the primary")
+	}
+	err = d.ExecTx(bob, func(ctx context.Context) error {
+		if !onPrimary(ctx) {
+			t.Error("transaction read a replica")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(150 * time.Millisecond)
+	if onPrimary(alice) {
+		t.Error("alice still reads the primary after the window")
+	}
+}
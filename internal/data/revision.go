@@ -0,0 +1,106 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ArticleRevision is the content of an article after one of its edits. Rows
+// are only ever inserted.
+type ArticleRevision struct {
+	ID           uint `gorm:"primarykey"`
+	CreatedAt    time.Time
+	ArticleID    uint   `gorm:"uniqueIndex:idx_article_revisions_number"`
+	Number       uint32 `gorm:"uniqueIndex:idx_article_revisions_number"`
+	Title        string `gorm:"size:200"`
+	Description  string `gorm:"size:200"`
+	Body         string
+	TagList      []string `gorm:"serializer:json"`
+	AuthorID     uint
+	RestoredFrom uint32
+}
+
+type revisionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewRevisionRepo(data *Data, logger log.Logger) biz.RevisionRepo {
+	if data.mem != nil {
+		return &memRevisionRepo{mem: data.mem}
+	}
+	return &revisionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func convertRevision(x ArticleRevision) *biz.Revision {
+	return &biz.Revision{
+		ID:           x.ID,
+		ArticleID:    x.ArticleID,
+		Number:       x.Number,
+		Title:        x.Title,
+		Description:  x.Description,
+		Body:         x.Body,
+		TagList:      x.TagList,
+		CreatedAt:    x.CreatedAt,
+		RestoredFrom: x.RestoredFrom,
+		AuthorID:     x.AuthorID,
+		Author:       &biz.Profile{ID: x.AuthorID},
+	}
+}
+
+// Create numbers the revision after the last one. Two edits of an article
+// racing for the same number fail on the unique index instead of overwriting.
+func (r *revisionRepo) Create(ctx context.Context, in *biz.Revision) (*biz.Revision, error) {
+	po := ArticleRevision{
+		ArticleID:    in.ArticleID,
+		Title:        in.Title,
+		Description:  in.Description,
+		Body:         in.Body,
+		TagList:      in.TagList,
+		AuthorID:     in.AuthorID,
+		RestoredFrom: in.RestoredFrom,
+	}
+	if po.TagList == nil {
+		po.TagList = []string{}
+	}
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		var last uint32
+		if err := r.data.DB(ctx).Model(&ArticleRevision{}).Where("article_id = ?", in.ArticleID).
+			Select("COALESCE(MAX(number), 0)").Scan(&last).Error; err != nil {
+			return err
+		}
+		po.Number = last + 1
+		return r.data.DB(ctx).Create(&po).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertRevision(po), nil
+}
+
+func (r *revisionRepo) List(ctx context.Context, articleID uint) ([]*biz.Revision, error) {
+	var revisions []ArticleRevision
+	if err := r.data.DB(ctx).Where("article_id = ?", articleID).Order("number desc").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Revision, len(revisions))
+	for i, x := range revisions {
+		rv[i] = convertRevision(x)
+	}
+	return rv, nil
+}
+
+func (r *revisionRepo) Get(ctx context.Context, articleID uint, number uint32) (*biz.Revision, error) {
+	var x ArticleRevision
+	if err := r.data.DB(ctx).Where("article_id = ? AND number = ?", articleID, number).First(&x).Error; err != nil {
+		return nil, err
+	}
+	return convertRevision(x), nil
+}
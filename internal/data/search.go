@@ -0,0 +1,158 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	// DatabaseSearch searches with the full-text features of the database.
+	DatabaseSearch = "database"
+	// BleveSearch searches an index embedded in the process, see bleveSearch.
+	BleveSearch = "bleve"
+)
+
+// NewSearchIndex returns the search engine configured in c.
+func NewSearchIndex(c *conf.Data, data *Data, logger log.Logger) (biz.SearchIndex, func(), error) {
+	engine := DatabaseSearch
+	if c.Search != nil && c.Search.Engine != "" {
+		engine = c.Search.Engine
+	}
+	switch engine {
+	case DatabaseSearch:
+		if data.mem != nil {
+			return &memSearch{mem: data.mem}, func() {}, nil
+		}
+		return &articleSearch{data: data}, func() {}, nil
+	case BleveSearch:
+		return newBleveSearch(data, logger)
+	default:
+		return nil, nil, fmt.Errorf("unknown search engine %q", engine)
+	}
+}
+
+// Title matches weigh more than description ones, which weigh more than body
+// ones, for the engines that score matches themselves.
+const (
+	titleWeight       = 3
+	descriptionWeight = 2
+	bodyWeight        = 1
+)
+
+// articleSearch is the database search engine: a FULLTEXT index on mysql, a
+// tsvector on postgres and a LIKE scan elsewhere. The index is the articles
+// table itself, so Index and Remove have nothing to do.
+type articleSearch struct {
+	data *Data
+}
+
+func (s *articleSearch) Index(context.Context, *biz.Article) error { return nil }
+
+func (s *articleSearch) Remove(context.Context, uint) error { return nil }
+
+// searchDocument must stay the expression of idx_articles_search on postgres.
+const searchDocument = `to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(description, '') || ' ' || coalesce(body, ''))`
+
+// match returns the condition of the articles matching terms and the
+// expression of their score, both taking args.
+func (s *articleSearch) match(text string, terms []string) (cond, score string, args []interface{}) {
+	switch s.data.db.Dialector.Name() {
+	case "mysql":
+		cond = "MATCH(title, description, body) AGAINST (? IN NATURAL LANGUAGE MODE)"
+		return cond, cond, []interface{}{text}
+	case "postgres":
+		// 词只含字母和数字, 可以直接拼进 tsquery
+		query := "to_tsquery('simple', ?)"
+		return searchDocument + " @@ " + query, "ts_rank(" + searchDocument + ", " + query + ")",
+			[]interface{}{strings.Join(terms, " | ")}
+	default:
+		var parts []string
+		for _, t := range terms {
+			like := "%" + t + "%"
+			parts = append(parts, fmt.Sprintf("CASE WHEN title LIKE ? THEN %d ELSE 0 END", titleWeight),
+				fmt.Sprintf("CASE WHEN description LIKE ? THEN %d ELSE 0 END", descriptionWeight),
+				fmt.Sprintf("CASE WHEN body LIKE ? THEN %d ELSE 0 END", bodyWeight))
+			args = append(args, like, like, like)
+		}
+		score = "(" + strings.Join(parts, " + ") + ")"
+		return score + " > 0", score, args
+	}
+}
+
+func (s *articleSearch) Search(ctx context.Context, q biz.SearchQuery) ([]biz.SearchHit, int64, error) {
+	cond, score, args := s.match(q.Text, biz.SearchTerms(q.Text))
+	db := filterArticles(ctx, s.data, q.ArticleQuery).Where(cond, args...).Session(&gorm.Session{})
+	var count int64
+	if err := db.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	var rows []struct {
+		ID    uint
+		Score float64
+	}
+	err := db.Select("id, "+score+" AS score", args...).Order("score desc, id desc").
+		Offset(int(q.Offset)).Limit(int(q.Limit)).Scan(&rows).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	rv := make([]biz.SearchHit, len(rows))
+	for i, x := range rows {
+		rv[i] = biz.SearchHit{ArticleID: x.ID, Score: x.Score}
+	}
+	return rv, count, nil
+}
+
+// memSearch is the database search engine of the memory driver, it scores
+// like the LIKE scan of articleSearch.
+type memSearch struct {
+	mem *memStore
+}
+
+func (s *memSearch) Index(context.Context, *biz.Article) error { return nil }
+
+func (s *memSearch) Remove(context.Context, uint) error { return nil }
+
+func (s *memSearch) Search(ctx context.Context, q biz.SearchQuery) ([]biz.SearchHit, int64, error) {
+	defer s.mem.lock(ctx)()
+	terms := biz.SearchTerms(q.Text)
+	var hits []biz.SearchHit
+	for _, a := range s.mem.filterArticles(q.ArticleQuery) {
+		var score int
+		for _, t := range terms {
+			if strings.Contains(strings.ToLower(a.Title), t) {
+				score += titleWeight
+			}
+			if strings.Contains(strings.ToLower(a.Description), t) {
+				score += descriptionWeight
+			}
+			if strings.Contains(strings.ToLower(a.Body), t) {
+				score += bodyWeight
+			}
+		}
+		if score > 0 {
+			hits = append(hits, biz.SearchHit{ArticleID: a.ID, Score: float64(score)})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ArticleID > hits[j].ArticleID
+	})
+	start, end := int(q.Offset), len(hits)
+	if start > end {
+		start = end
+	}
+	if q.Limit > 0 && start+int(q.Limit) < end {
+		end = start + int(q.Limit)
+	}
+	return hits[start:end], int64(len(hits)), nil
+}
@@ -0,0 +1,329 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"realworld/internal/biz"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/plugin/dbresolver"
+)
+
+// fillBatchSize is how many articles are read and indexed at once when the
+// index is filled.
+const fillBatchSize = 500
+
+// bleveArticle is the document indexed for a published article. Owner is
+// among Authors too, it is also kept alone for the privacy of its account.
+type bleveArticle struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Body        string    `json:"body"`
+	Tags        []string  `json:"tags"`
+	Authors     []float64 `json:"authors"`
+	Owner       float64   `json:"owner"`
+	Created     time.Time `json:"created"`
+}
+
+func newBleveArticle(a *biz.Article) bleveArticle {
+	authors := []float64{float64(a.AuthorUserID)}
+	for _, id := range a.CoAuthorIDs {
+		authors = append(authors, float64(id))
+	}
+	return bleveArticle{
+		Title:       a.Title,
+		Description: a.Description,
+		Body:        a.Body,
+		Tags:        a.TagList,
+		Authors:     authors,
+		Owner:       float64(a.AuthorUserID),
+		Created:     a.CreatedAt,
+	}
+}
+
+// bleveSearch keeps the published articles in an in-memory bleve index. It is
+// filled from the database at start and then follows the writes of this
+// instance only: run a single instance, or use the database engine.
+type bleveSearch struct {
+	index bleve.Index
+	data  *Data
+	log   *log.Helper
+
+	// touched has the articles written while filling, which the fill must not
+	// overwrite with what it read before. It is nil once filled.
+	mu      sync.Mutex
+	touched map[uint]bool
+}
+
+func newBleveSearch(data *Data, logger log.Logger) (*bleveSearch, func(), error) {
+	index, err := bleve.NewMemOnly(articleMapping())
+	if err != nil {
+		return nil, nil, err
+	}
+	s := &bleveSearch{index: index, data: data, log: log.NewHelper(logger), touched: make(map[uint]bool)}
+	ctx, cancel := context.WithCancel(context.Background())
+	go s.fill(ctx)
+	return s, func() {
+		cancel()
+		if err := index.Close(); err != nil {
+			s.log.Error(err)
+		}
+	}, nil
+}
+
+func articleMapping() mapping.IndexMapping {
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = en.AnalyzerName
+	text.Store = false
+	tags := bleve.NewTextFieldMapping()
+	tags.Analyzer = keyword.Name
+	tags.Store = false
+	author := bleve.NewNumericFieldMapping()
+	author.Store = false
+	created := bleve.NewDateTimeFieldMapping()
+	created.Store = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	doc.AddFieldMappingsAt("title", text)
+	doc.AddFieldMappingsAt("description", text)
+	doc.AddFieldMappingsAt("body", text)
+	doc.AddFieldMappingsAt("tags", tags)
+	doc.AddFieldMappingsAt("authors", author)
+	doc.AddFieldMappingsAt("owner", author)
+	doc.AddFieldMappingsAt("created", created)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	return m
+}
+
+func (s *bleveSearch) Index(ctx context.Context, a *biz.Article) error {
+	s.touch(a.ID)
+	if a.Status != biz.StatusPublished {
+		return s.index.Delete(strconv.Itoa(int(a.ID)))
+	}
+	return s.index.Index(strconv.Itoa(int(a.ID)), newBleveArticle(a))
+}
+
+func (s *bleveSearch) Remove(ctx context.Context, id uint) error {
+	s.touch(id)
+	return s.index.Delete(strconv.Itoa(int(id)))
+}
+
+func (s *bleveSearch) touch(id uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.touched != nil {
+		s.touched[id] = true
+	}
+}
+
+func fieldMatch(field, text string, boost float64) query.Query {
+	q := bleve.NewMatchQuery(text)
+	q.SetField(field)
+	q.SetBoost(boost)
+	return q
+}
+
+// userQuery matches the documents with user id in field.
+func userQuery(field string, id uint) query.Query {
+	v, inclusive := float64(id), true
+	q := bleve.NewNumericRangeInclusiveQuery(&v, &v, &inclusive, &inclusive)
+	q.SetField(field)
+	return q
+}
+
+func tagQueries(tags []string) []query.Query {
+	rv := make([]query.Query, len(tags))
+	for i, t := range tags {
+		tq := bleve.NewTermQuery(t)
+		tq.SetField("tags")
+		rv[i] = tq
+	}
+	return rv
+}
+
+func (s *bleveSearch) Search(ctx context.Context, q biz.SearchQuery) ([]biz.SearchHit, int64, error) {
+	conj := bleve.NewConjunctionQuery(bleve.NewDisjunctionQuery(
+		fieldMatch("title", q.Text, titleWeight),
+		fieldMatch("description", q.Text, descriptionWeight),
+		fieldMatch("body", q.Text, bodyWeight),
+	))
+	if len(q.Tags) > 0 {
+		if q.AllTags {
+			conj.AddQuery(tagQueries(q.Tags)...)
+		} else {
+			conj.AddQuery(bleve.NewDisjunctionQuery(tagQueries(q.Tags)...))
+		}
+	}
+	if q.AuthorID > 0 {
+		conj.AddQuery(userQuery("authors", q.AuthorID))
+	}
+	if !q.CreatedAfter.IsZero() || !q.CreatedBefore.IsZero() {
+		inclusive, exclusive := true, false
+		created := bleve.NewDateRangeInclusiveQuery(q.CreatedAfter, q.CreatedBefore, &inclusive, &exclusive)
+		created.SetField("created")
+		conj.AddQuery(created)
+	}
+	if q.FavoritedBy != "" || q.SeriesID > 0 {
+		// 收藏和系列随时会变, 不进索引, 先查出文章
+		ids, err := s.data.articleIDs(ctx, biz.ArticleQuery{FavoritedBy: q.FavoritedBy, SeriesID: q.SeriesID})
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(ids) == 0 {
+			return []biz.SearchHit{}, 0, nil
+		}
+		docs := make([]string, len(ids))
+		for i, id := range ids {
+			docs[i] = strconv.Itoa(int(id))
+		}
+		conj.AddQuery(bleve.NewDocIDQuery(docs))
+	}
+	not := tagQueries(q.ExcludeTags)
+	if q.HidePrivate {
+		// 私密账号随时会变, 不进索引, 查询时排除
+		owners, err := s.data.hiddenOwners(ctx, q.Viewer)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(owners) > 0 {
+			hidden := bleve.NewBooleanQuery()
+			ownerQueries := make([]query.Query, len(owners))
+			for i, id := range owners {
+				ownerQueries[i] = userQuery("owner", id)
+			}
+			hidden.AddMust(bleve.NewDisjunctionQuery(ownerQueries...))
+			if q.Viewer > 0 {
+				hidden.AddMustNot(userQuery("authors", q.Viewer))
+			}
+			not = append(not, hidden)
+		}
+	}
+	var qq query.Query = conj
+	if len(not) > 0 {
+		b := bleve.NewBooleanQuery()
+		b.AddMust(conj)
+		b.AddMustNot(not...)
+		qq = b
+	}
+	res, err := s.index.SearchInContext(ctx, bleve.NewSearchRequestOptions(qq, int(q.Limit), int(q.Offset), false))
+	if err != nil {
+		return nil, 0, err
+	}
+	rv := make([]biz.SearchHit, 0, len(res.Hits))
+	for _, h := range res.Hits {
+		id, err := strconv.ParseUint(h.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		rv = append(rv, biz.SearchHit{ArticleID: uint(id), Score: h.Score})
+	}
+	return rv, int64(res.Total), nil
+}
+
+// fill indexes the published articles once the database is ready.
+func (s *bleveSearch) fill(ctx context.Context) {
+	for s.data.Ready(ctx) != nil {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(minConnectDelay):
+		}
+	}
+	var after uint
+	for {
+		articles, err := s.data.publishedArticles(ctx, after, fillBatchSize)
+		if err != nil {
+			s.log.Errorf("fill search index: %v", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(maxConnectDelay):
+			}
+			continue
+		}
+		if len(articles) == 0 {
+			break
+		}
+		if err := s.indexBatch(articles); err != nil {
+			s.log.Errorf("fill search index: %v", err)
+			return
+		}
+		after = articles[len(articles)-1].ID
+	}
+	s.mu.Lock()
+	s.touched = nil
+	s.mu.Unlock()
+	n, _ := s.index.DocCount()
+	s.log.Infof("search index is filled with %d articles", n)
+}
+
+func (s *bleveSearch) indexBatch(articles []*biz.Article) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.index.NewBatch()
+	for _, a := range articles {
+		if s.touched[a.ID] {
+			continue
+		}
+		if err := b.Index(strconv.Itoa(int(a.ID)), newBleveArticle(a)); err != nil {
+			return err
+		}
+	}
+	return s.index.Batch(b)
+}
+
+// articleIDs returns the ids of the published articles matching q.
+func (d *Data) articleIDs(ctx context.Context, q biz.ArticleQuery) ([]uint, error) {
+	if d.mem != nil {
+		defer d.mem.lock(ctx)()
+		var rv []uint
+		for _, a := range d.mem.filterArticles(q) {
+			rv = append(rv, a.ID)
+		}
+		return rv, nil
+	}
+	var rv []uint
+	err := filterArticles(ctx, d, q).Pluck("id", &rv).Error
+	return rv, err
+}
+
+// publishedArticles returns up to limit published articles with an id after
+// after, in id order, with their tags.
+func (d *Data) publishedArticles(ctx context.Context, after uint, limit int) ([]*biz.Article, error) {
+	if d.mem != nil {
+		defer d.mem.lock(ctx)()
+		var rv []*biz.Article
+		for _, a := range d.mem.filterArticles(biz.ArticleQuery{}) {
+			if a.ID > after {
+				rv = append(rv, d.mem.convertArticle(a))
+			}
+		}
+		sort.Slice(rv, func(i, j int) bool { return rv[i].ID < rv[j].ID })
+		if len(rv) > limit {
+			rv = rv[:limit]
+		}
+		return rv, nil
+	}
+	var articles []Article
+	err := d.DB(ctx).Clauses(dbresolver.Write).Preload("Tags").Scopes(preloadCoAuthors).
+		Where("status = ? AND id > ?", biz.StatusPublished, after).Order("id").Limit(limit).Find(&articles).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Article, len(articles))
+	for i, x := range articles {
+		rv[i] = convertArticle(x)
+	}
+	return rv, nil
+}
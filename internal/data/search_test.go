@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+)
+
+func TestDatabaseSearch(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			si, cleanup, err := NewSearchIndex(&conf.Data{}, d, testLogger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer cleanup()
+			ar := NewArticleRepo(d, testLogger)
+			uid := createTestUser(t, d, "alice")
+			body := createTestArticle(t, ar, uid, "first")
+			title := createTestArticle(t, ar, uid, "dragons")
+			createTestArticle(t, ar, uid, "third")
+			if _, err := ar.Update(ctx, &biz.Article{ID: body.ID, Slug: body.Slug, Title: body.Title, Body: "about dragons", Status: biz.StatusPublished}); err != nil {
+				t.Fatal(err)
+			}
+
+			search := func(q biz.SearchQuery) []uint {
+				t.Helper()
+				hits, count, err := si.Search(ctx, q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if count != 2 {
+					t.Errorf("count = %d, want 2", count)
+				}
+				var ids []uint
+				for _, h := range hits {
+					ids = append(ids, h.ArticleID)
+				}
+				return ids
+			}
+			// 标题命中的分数更高
+			if got, want := search(biz.SearchQuery{Text: "Dragons", ArticleQuery: biz.ArticleQuery{Limit: 10}}), []uint{title.ID, body.ID}; !reflect.DeepEqual(got, want) {
+				t.Errorf("hits = %v, want %v", got, want)
+			}
+			if got, want := search(biz.SearchQuery{Text: "dragons", ArticleQuery: biz.ArticleQuery{Limit: 1, Offset: 1}}), []uint{body.ID}; !reflect.DeepEqual(got, want) {
+				t.Errorf("second page = %v, want %v", got, want)
+			}
+		})
+	}
+}
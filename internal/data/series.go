@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type Series struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	AuthorID  uint   `gorm:"index"`
+	Title     string `gorm:"size:200"`
+}
+
+// SeriesArticle puts an article at Position in a series, positions counting
+// from 1.
+type SeriesArticle struct {
+	ID        uint `gorm:"primarykey"`
+	SeriesID  uint `gorm:"index"`
+	ArticleID uint `gorm:"uniqueIndex"`
+	Position  uint32
+}
+
+type seriesRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSeriesRepo(data *Data, logger log.Logger) biz.SeriesRepo {
+	if data.mem != nil {
+		return &memSeriesRepo{mem: data.mem}
+	}
+	return &seriesRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func convertSeries(x Series) *biz.Series {
+	return &biz.Series{
+		ID:        x.ID,
+		Title:     x.Title,
+		CreatedAt: x.CreatedAt,
+		UpdatedAt: x.UpdatedAt,
+		AuthorID:  x.AuthorID,
+		Author:    &biz.Profile{ID: x.AuthorID},
+	}
+}
+
+func (r *seriesRepo) Create(ctx context.Context, in *biz.Series) (*biz.Series, error) {
+	po := Series{Title: in.Title, AuthorID: in.AuthorID}
+	if err := r.data.DB(ctx).Create(&po).Error; err != nil {
+		return nil, err
+	}
+	return convertSeries(po), nil
+}
+
+func (r *seriesRepo) Get(ctx context.Context, id uint) (*biz.Series, error) {
+	var po Series
+	err := r.data.DB(ctx).Where("id = ?", id).First(&po).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrSeriesNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertSeries(po), nil
+}
+
+func (r *seriesRepo) Rename(ctx context.Context, id uint, title string) error {
+	return r.data.DB(ctx).Model(&Series{ID: id}).Update("title", title).Error
+}
+
+func (r *seriesRepo) Delete(ctx context.Context, id uint) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Where("series_id = ?", id).Delete(&SeriesArticle{}).Error; err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Delete(&Series{}, id).Error
+	})
+}
+
+func (r *seriesRepo) Articles(ctx context.Context, id uint) ([]*biz.Article, error) {
+	var articles []Article
+	err := r.data.DB(ctx).Preload("Tags").Scopes(preloadCoAuthors).
+		Joins("JOIN series_articles ON series_articles.article_id = articles.id").
+		Where("series_articles.series_id = ?", id).Order("series_articles.position").Find(&articles).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Article, len(articles))
+	for i, x := range articles {
+		rv[i] = convertArticle(x)
+	}
+	return rv, nil
+}
+
+func (r *seriesRepo) SetArticles(ctx context.Context, id uint, articleIDs []uint) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Where("series_id = ?", id).Delete(&SeriesArticle{}).Error; err != nil {
+			return err
+		}
+		if len(articleIDs) == 0 {
+			return nil
+		}
+		rows := make([]SeriesArticle, len(articleIDs))
+		for i, aid := range articleIDs {
+			rows[i] = SeriesArticle{SeriesID: id, ArticleID: aid, Position: uint32(i + 1)}
+		}
+		if err := r.data.DB(ctx).Create(&rows).Error; err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Model(&Series{ID: id}).Update("updated_at", time.Now()).Error
+	})
+}
+
+func (r *seriesRepo) OfArticle(ctx context.Context, articleID uint) (uint, error) {
+	var ids []uint
+	err := r.data.DB(ctx).Model(&SeriesArticle{}).Where("article_id = ?", articleID).Limit(1).Pluck("series_id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	return ids[0], nil
+}
@@ -0,0 +1,55 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"realworld/internal/biz"
+)
+
+func TestSeriesArticles(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar, sr := NewArticleRepo(d, testLogger), NewSeriesRepo(d, testLogger)
+			uid := createTestUser(t, d, "alice")
+			a1, a2, a3 := createTestArticle(t, ar, uid, "one"), createTestArticle(t, ar, uid, "two"), createTestArticle(t, ar, uid, "three")
+			s, err := sr.Create(ctx, &biz.Series{Title: "parts", AuthorID: uid})
+			if err != nil {
+				t.Fatal(err)
+			}
+			check := func(want ...string) {
+				t.Helper()
+				rv, err := sr.Articles(ctx, s.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := titles(rv); !reflect.DeepEqual(got, append([]string{}, want...)) {
+					t.Errorf("articles = %q, want %q", got, want)
+				}
+			}
+			for _, ids := range [][]uint{{a3.ID, a1.ID, a2.ID}, {a2.ID, a3.ID}} {
+				if err := sr.SetArticles(ctx, s.ID, ids); err != nil {
+					t.Fatal(err)
+				}
+			}
+			check("two", "three")
+			for a, want := range map[*biz.Article]uint{a1: 0, a2: s.ID} {
+				if got, err := sr.OfArticle(ctx, a.ID); err != nil || got != want {
+					t.Errorf("OfArticle(%s) = %d, %v, want %d", a.Title, got, err, want)
+				}
+			}
+			if err := sr.Delete(ctx, s.ID); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := sr.OfArticle(ctx, a2.ID); err != nil || got != 0 {
+				t.Errorf("OfArticle after delete = %d, %v", got, err)
+			}
+			if _, err := ar.GetArticle(ctx, a2.ID); err != nil {
+				t.Errorf("article of a deleted series: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+)
+
+const (
+	defaultTimelineSize = 1000
+	defaultFanoutLimit  = 10000
+)
+
+// TimelineEntry puts an article in the timeline of a follower of its author.
+type TimelineEntry struct {
+	ID        uint `gorm:"primarykey"`
+	UserID    uint `gorm:"uniqueIndex:idx_timeline_entries_user_article;index:idx_timeline_entries_user_author,priority:1"`
+	ArticleID uint `gorm:"uniqueIndex:idx_timeline_entries_user_article;index"`
+	AuthorID  uint `gorm:"index:idx_timeline_entries_user_author,priority:2"`
+}
+
+type timelineRepo struct {
+	data *Data
+	// size is how many articles a timeline holds, fanoutLimit how many
+	// followers an author may have for its articles to be pushed.
+	size        int
+	fanoutLimit int64
+	log         *log.Helper
+}
+
+// NewTimelineRepo .
+func NewTimelineRepo(c *conf.Data, data *Data, logger log.Logger) biz.TimelineRepo {
+	size, limit := timelineSize(c), int64(defaultFanoutLimit)
+	if c.Timeline != nil && c.Timeline.FanoutLimit > 0 {
+		limit = c.Timeline.FanoutLimit
+	}
+	if data.mem != nil {
+		return &memTimelineRepo{mem: data.mem, size: size, fanoutLimit: limit}
+	}
+	return &timelineRepo{data: data, size: size, fanoutLimit: limit, log: log.NewHelper(logger)}
+}
+
+func (r *timelineRepo) Push(ctx context.Context, articleID, authorID uint) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		var followers int64
+		if err := r.data.DB(ctx).Model(&Follow{}).Where("follow_id = ?", authorID).Count(&followers).Error; err != nil {
+			return err
+		}
+		if followers > r.fanoutLimit {
+			return nil
+		}
+		res := r.data.DB(ctx).Model(&Article{}).Where("id = ? AND fanned_out = ?", articleID, false).UpdateColumn("fanned_out", true)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		// 关注可能有重复的行
+		return r.data.DB(ctx).Exec("INSERT INTO timeline_entries (user_id, article_id, author_id)"+
+			" SELECT DISTINCT user_id, ?, ? FROM follows WHERE follow_id = ? AND deleted_at IS NULL"+
+			" AND user_id NOT IN (SELECT user_id FROM timeline_entries WHERE article_id = ?)",
+			articleID, authorID, authorID, articleID).Error
+	})
+}
+
+func (r *timelineRepo) RemoveArticle(ctx context.Context, articleID uint) error {
+	return r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Where("article_id = ?", articleID).Delete(&TimelineEntry{}).Error; err != nil {
+			return err
+		}
+		return r.data.DB(ctx).Unscoped().Model(&Article{}).Where("id = ?", articleID).UpdateColumn("fanned_out", false).Error
+	})
+}
+
+func (r *timelineRepo) Backfill(ctx context.Context, userID, authorID uint) error {
+	var ids []uint
+	err := r.data.DB(ctx).Model(&Article{}).
+		Where("author_id = ? AND status = ? AND fanned_out = ?", authorID, biz.StatusPublished, true).
+		Where("id NOT IN (?)", r.data.DB(ctx).Model(&TimelineEntry{}).Where("user_id = ?", userID).Select("article_id")).
+		Order("id DESC").Limit(r.size).Pluck("id", &ids).Error
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	entries := make([]TimelineEntry, len(ids))
+	for i, id := range ids {
+		entries[i] = TimelineEntry{UserID: userID, ArticleID: id, AuthorID: authorID}
+	}
+	return r.data.DB(ctx).Create(&entries).Error
+}
+
+func (r *timelineRepo) RemoveAuthor(ctx context.Context, userID, authorID uint) error {
+	return r.data.DB(ctx).Where("user_id = ? AND author_id = ?", userID, authorID).Delete(&TimelineEntry{}).Error
+}
+
+func (r *timelineRepo) Trim(ctx context.Context) (n int64, err error) {
+	var users []uint
+	err = r.data.DB(ctx).Model(&TimelineEntry{}).Group("user_id").Having("COUNT(*) > ?", r.size).Pluck("user_id", &users).Error
+	if err != nil {
+		return 0, err
+	}
+	for _, uid := range users {
+		// 保留最新的 size 篇, 文章 id 就是创建顺序
+		var last []uint
+		err := r.data.DB(ctx).Model(&TimelineEntry{}).Where("user_id = ?", uid).
+			Order("article_id DESC").Offset(r.size).Limit(1).Pluck("article_id", &last).Error
+		if err != nil {
+			return n, err
+		}
+		if len(last) == 0 {
+			continue
+		}
+		res := r.data.DB(ctx).Where("user_id = ? AND article_id <= ?", uid, last[0]).Delete(&TimelineEntry{})
+		if res.Error != nil {
+			return n, res.Error
+		}
+		n += res.RowsAffected
+	}
+	return n, nil
+}
+
+// timelineSize is the size of the timelines set in c.
+func timelineSize(c *conf.Data) int {
+	if c.Timeline != nil && c.Timeline.Size > 0 {
+		return int(c.Timeline.Size)
+	}
+	return defaultTimelineSize
+}
+
+// inFeed keeps the articles in the feed of user uid, see biz.ArticleQuery.
+// The articles that were not pushed are looked up through follows, no further
+// back than the oldest article a full timeline keeps.
+func inFeed(ctx context.Context, d *Data, uid uint) *gorm.DB {
+	// 时间线没满时下限是 0, 满了就和 Trim 保留的范围一样
+	oldest := d.DB(ctx).Model(&TimelineEntry{}).Where("user_id = ?", uid).
+		Order("article_id DESC").Offset(d.timelineSize - 1).Limit(1).Select("article_id")
+	return d.DB(ctx).Where("id IN (?)", d.DB(ctx).Model(&TimelineEntry{}).Where("user_id = ?", uid).Select("article_id")).
+		Or("fanned_out = ? AND author_id IN (?) AND id >= COALESCE((?), 0)",
+			false, d.DB(ctx).Model(&Follow{}).Where("user_id = ?", uid).Select("follow_id"), oldest)
+}
@@ -0,0 +1,145 @@
+package data
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+)
+
+func TestTimelines(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar := NewArticleRepo(d, testLogger)
+			pr := NewProfileRepo(d, testLogger)
+			tl := NewTimelineRepo(&conf.Data{Timeline: &conf.Data_Timeline{Size: 2, FanoutLimit: 1}}, d, testLogger)
+			alice, bob := createTestUser(t, d, "alice"), createTestUser(t, d, "bob")
+			carol, dave := createTestUser(t, d, "carol"), createTestUser(t, d, "dave")
+			follow := func(uid uint, name string) {
+				t.Helper()
+				p, err := pr.FollowUser(ctx, uid, name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := tl.Backfill(ctx, uid, p.ID); err != nil {
+					t.Fatal(err)
+				}
+			}
+			publish := func(authorID uint, title string) *biz.Article {
+				t.Helper()
+				a := createTestArticle(t, ar, authorID, title)
+				if err := tl.Push(ctx, a.ID, authorID); err != nil {
+					t.Fatal(err)
+				}
+				return a
+			}
+			feed := func(want ...string) {
+				t.Helper()
+				rv, _, err := ar.List(ctx, biz.ArticleQuery{FeedOf: alice})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := titles(rv); !reflect.DeepEqual(got, append([]string{}, want...)) {
+					t.Errorf("feed = %q, want %q", got, want)
+				}
+			}
+
+			// 推送给已有的关注者
+			follow(alice, "carol")
+			publish(carol, "c1")
+			feed("c1")
+			// 取关后从时间线移除
+			p, err := pr.UnFollowUser(ctx, alice, "carol")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tl.RemoveAuthor(ctx, alice, p.ID); err != nil {
+				t.Fatal(err)
+			}
+			feed()
+			// 新关注补上最近的 size 篇
+			publish(carol, "c2")
+			c3 := publish(carol, "c3")
+			follow(alice, "carol")
+			feed("c3", "c2")
+			// 删掉的文章移出时间线
+			if err := tl.RemoveArticle(ctx, c3.ID); err != nil {
+				t.Fatal(err)
+			}
+			if err := ar.Delete(ctx, c3); err != nil {
+				t.Fatal(err)
+			}
+			feed("c2")
+
+			// 关注者超过上限的作者不推送, 读 feed 时按关注查
+			follow(alice, "dave")
+			follow(bob, "dave")
+			publish(dave, "d1")
+			publish(carol, "c4")
+			feed("c4", "d1", "c2")
+
+			// alice 的时间线有 c2 c4, 没有超出
+			if n, err := tl.Trim(ctx); n != 0 || err != nil {
+				t.Errorf("trim = %d, %v", n, err)
+			}
+			publish(carol, "c5")
+			if n, err := tl.Trim(ctx); n != 1 || err != nil {
+				t.Errorf("trim = %d, %v", n, err)
+			}
+			feed("c5", "c4", "d1")
+		})
+	}
+}
+
+func TestFeedWindow(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			d.timelineSize = 2
+			if d.mem != nil {
+				d.mem.timelineSize = 2
+			}
+			ar := NewArticleRepo(d, testLogger)
+			pr := NewProfileRepo(d, testLogger)
+			tl := NewTimelineRepo(&conf.Data{Timeline: &conf.Data_Timeline{Size: 2}}, d, testLogger)
+			alice, bob, carol := createTestUser(t, d, "alice"), createTestUser(t, d, "bob"), createTestUser(t, d, "carol")
+			for _, name := range []string{"bob", "carol"} {
+				if _, err := pr.FollowUser(ctx, alice, name); err != nil {
+					t.Fatal(err)
+				}
+			}
+			feed := func() []string {
+				t.Helper()
+				rv, _, err := ar.List(ctx, biz.ArticleQuery{FeedOf: alice})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return titles(rv)
+			}
+
+			// the articles of bob are not pushed, as for an author over the
+			// fan-out limit
+			createTestArticle(t, ar, bob, "b1")
+			createTestArticle(t, ar, bob, "b2")
+			if got, want := feed(), []string{"b2", "b1"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("feed = %q, want %q", got, want)
+			}
+			for _, title := range []string{"c1", "c2"} {
+				a := createTestArticle(t, ar, carol, title)
+				if err := tl.Push(ctx, a.ID, carol); err != nil {
+					t.Fatal(err)
+				}
+			}
+			createTestArticle(t, ar, bob, "b3")
+			// the timeline is full, the older articles of bob are past it
+			if got, want := feed(), []string{"b3", "c2", "c1"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("feed = %q, want %q", got, want)
+			}
+		})
+	}
+}
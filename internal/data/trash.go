@@ -0,0 +1,143 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"realworld/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// purgeBatchSize is how many articles a purge deletes at most, the rest go at
+// the next one.
+const purgeBatchSize = 500
+
+type trashRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTrashRepo(data *Data, logger log.Logger) biz.TrashRepo {
+	if data.mem != nil {
+		return &memTrashRepo{mem: data.mem}
+	}
+	return &trashRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// trashed selects the soft deleted rows of the model of db.
+func trashed(db *gorm.DB) *gorm.DB {
+	return db.Unscoped().Where("deleted_at IS NOT NULL")
+}
+
+func (r *trashRepo) Articles(ctx context.Context, authorID uint) ([]*biz.Article, error) {
+	var articles []Article
+	err := r.data.DB(ctx).Scopes(trashed).Preload("Tags").Scopes(preloadCoAuthors).
+		Where("author_id = ?", authorID).Order("deleted_at desc, id desc").Find(&articles).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Article, len(articles))
+	for i, x := range articles {
+		rv[i] = convertArticle(x)
+	}
+	return rv, nil
+}
+
+func (r *trashRepo) Comments(ctx context.Context, authorID uint) ([]*biz.Comment, error) {
+	var comments []Comment
+	err := r.data.DB(ctx).Scopes(trashed).Where("author_id = ?", authorID).
+		Where("article_id IN (?)", r.data.DB(ctx).Model(&Article{}).Select("id")).
+		Order("deleted_at desc, id desc").Find(&comments).Error
+	if err != nil {
+		return nil, err
+	}
+	rv := make([]*biz.Comment, len(comments))
+	for i, x := range comments {
+		rv[i] = convertComment(x)
+	}
+	return rv, nil
+}
+
+func (r *trashRepo) Article(ctx context.Context, id uint) (*biz.Article, error) {
+	var x Article
+	err := r.data.DB(ctx).Scopes(trashed).Preload("Tags").Scopes(preloadCoAuthors).Where("id = ?", id).First(&x).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrArticleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertArticle(x), nil
+}
+
+func (r *trashRepo) Comment(ctx context.Context, id uint) (*biz.Comment, error) {
+	var x Comment
+	err := r.data.DB(ctx).Scopes(trashed).Where("id = ?", id).First(&x).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, biz.ErrCommentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return convertComment(x), nil
+}
+
+func (r *trashRepo) RestoreArticle(ctx context.Context, id uint) error {
+	err := r.data.DB(ctx).Unscoped().Model(&Article{}).Where("id = ?", id).Update("deleted_at", nil).Error
+	r.data.cache.del(ctx, articleIdCacheKey(id))
+	return err
+}
+
+func (r *trashRepo) RestoreComment(ctx context.Context, id uint) error {
+	return r.data.DB(ctx).Unscoped().Model(&Comment{}).Where("id = ?", id).Update("deleted_at", nil).Error
+}
+
+// Purge moves the slugs of the purged articles to the slug history, where
+// they keep pointing to the article that had them, see SlugOwner.
+func (r *trashRepo) Purge(ctx context.Context, before time.Time) (articles, comments int64, err error) {
+	err = r.data.ExecTx(ctx, func(ctx context.Context) error {
+		var rows []Article
+		if err := r.data.DB(ctx).Scopes(trashed).Where("deleted_at < ?", before).
+			Select("id", "slug").Order("id").Limit(purgeBatchSize).Find(&rows).Error; err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			ids := make([]uint, len(rows))
+			slugs := make([]ArticleSlug, len(rows))
+			for i, x := range rows {
+				ids[i] = x.ID
+				slugs[i] = ArticleSlug{ArticleID: x.ID, Slug: x.Slug}
+			}
+			if err := r.data.DB(ctx).Create(&slugs).Error; err != nil {
+				return err
+			}
+			db := r.data.DB(ctx).Unscoped().Session(&gorm.Session{})
+			for _, model := range []interface{}{&ArticleFavorite{}, &Comment{}, &ArticleRevision{}, &SeriesArticle{}, &ArticleAuthor{}} {
+				if err := db.Where("article_id IN ?", ids).Delete(model).Error; err != nil {
+					return err
+				}
+			}
+			if err := db.Table("article_tags").Where("article_id IN ?", ids).Delete(&struct{}{}).Error; err != nil {
+				return err
+			}
+			res := db.Where("id IN ?", ids).Delete(&Article{})
+			if res.Error != nil {
+				return res.Error
+			}
+			articles = res.RowsAffected
+		}
+		res := r.data.DB(ctx).Scopes(trashed).Where("deleted_at < ?", before).Delete(&Comment{})
+		comments = res.RowsAffected
+		return res.Error
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return articles, comments, nil
+}
@@ -0,0 +1,64 @@
+package data
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"realworld/internal/biz"
+)
+
+func TestTrashPurge(t *testing.T) {
+	for _, driver := range testDrivers {
+		t.Run(driver, func(t *testing.T) {
+			ctx := context.Background()
+			d := newTestData(t, driver, nil)
+			ar, cr, tr := NewArticleRepo(d, testLogger), NewCommentRepo(d, testLogger), NewTrashRepo(d, testLogger)
+			alice := createTestUser(t, d, "alice")
+			gone := createTestArticle(t, ar, alice, "gone")
+			kept := createTestArticle(t, ar, alice, "kept")
+			comment := func(a *biz.Article) *biz.Comment {
+				t.Helper()
+				c, err := cr.Create(ctx, &biz.Comment{Body: "body", ArticleID: a.ID, AuthorID: alice})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return c
+			}
+			comment(gone)
+			c := comment(kept)
+			if err := ar.Delete(ctx, gone); err != nil {
+				t.Fatal(err)
+			}
+			if err := cr.Delete(ctx, c.ID); err != nil {
+				t.Fatal(err)
+			}
+
+			if rv, err := tr.Articles(ctx, alice); err != nil || len(rv) != 1 || rv[0].ID != gone.ID {
+				t.Fatalf("trashed articles = %v, %v", rv, err)
+			}
+			if rv, err := tr.Comments(ctx, alice); err != nil || len(rv) != 1 || rv[0].ID != c.ID {
+				t.Fatalf("trashed comments = %v, %v", rv, err)
+			}
+			if a, c, err := tr.Purge(ctx, time.Now().Add(-time.Hour)); a != 0 || c != 0 || err != nil {
+				t.Errorf("purge of nothing old = %d, %d, %v", a, c, err)
+			}
+			if a, c, err := tr.Purge(ctx, time.Now().Add(time.Second)); a != 1 || c != 1 || err != nil {
+				t.Errorf("purge = %d, %d, %v", a, c, err)
+			}
+			if _, err := tr.Article(ctx, gone.ID); err != biz.ErrArticleNotFound {
+				t.Errorf("purged article: %v", err)
+			}
+			if _, err := tr.Comment(ctx, c.ID); err != biz.ErrCommentNotFound {
+				t.Errorf("purged comment: %v", err)
+			}
+			// 清除后 slug 仍然占着
+			if id, err := ar.SlugOwner(ctx, gone.Slug); err != nil || id != gone.ID {
+				t.Errorf("SlugOwner(%q) = %d, %v", gone.Slug, id, err)
+			}
+			if _, err := ar.GetArticle(ctx, kept.ID); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -23,10 +23,20 @@ type User struct {
 	Image        string `gorm:"size:1000"`
 	PasswordHash string `gorm:"size:500"`
 	Following    uint32
+	// FollowersCount, FollowingCount and ArticlesCount are kept up to date
+	// by ProfileRepo.
+	FollowersCount uint32
+	FollowingCount uint32
+	ArticlesCount  uint32
+	// Private users approve who follows them, see FollowRequest.
+	Private bool
 }
 
 // NewGreeterRepo .
 func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
+	if data.mem != nil {
+		return &memUserRepo{mem: data.mem}
+	}
 	return &userRepo{
 		data: data,
 		log:  log.NewHelper(logger),
@@ -56,7 +66,7 @@ func (r *userRepo) ListAll(context.Context) ([]*biz.User, error) {
 
 func (r *userRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User, error) {
 	u := new(User)
-	res := r.data.db.Where("email = ? ", email).First(u)
+	res := r.data.DB(ctx).Where("email = ? ", email).First(u)
 	if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 		return nil, errors.NotFound("user", "not found by email")
 	}
@@ -74,7 +84,7 @@ func (r *userRepo) GetUserByEmail(ctx context.Context, email string) (*biz.User,
 }
 func (r *userRepo) GetUserById(ctx context.Context, id uint) (*biz.User, error) {
 	u := new(User)
-	res := r.data.db.Where("id = ?", id).First(&u)
+	res := r.data.DB(ctx).Where("id = ?", id).First(&u)
 	if res.Error != nil {
 		return nil, res.Error
 	}
@@ -84,6 +94,7 @@ func (r *userRepo) GetUserById(ctx context.Context, id uint) (*biz.User, error)
 		Bio:          u.Bio,
 		Image:        u.Image,
 		PasswordHash: u.PasswordHash,
+		Private:      u.Private,
 	}, nil
 }
 func (r *userRepo) CreateUser(ctx context.Context, u *biz.User) error {
@@ -94,7 +105,7 @@ func (r *userRepo) CreateUser(ctx context.Context, u *biz.User) error {
 		Image:        u.Image,
 		PasswordHash: u.PasswordHash,
 	}
-	rv := r.data.db.Create(&user)
+	rv := r.data.DB(ctx).Create(&user)
 	if rv.Error == nil {
 		fmt.Println("ID:", user.ID)
 		u.Id = user.ID
@@ -110,10 +121,32 @@ func (r *userRepo) UpdateUser(ctx context.Context, id uint, u *biz.UpdateUser) e
 		Image:        u.Image,
 		PasswordHash: u.PasswordHash,
 	}
-	if err := r.data.db.Where("id = ? ", id).Updates(&user).Error; err != nil {
+	var old User
+	err := r.data.ExecTx(ctx, func(ctx context.Context) error {
+		if err := r.data.DB(ctx).Where("id = ? ", id).First(&old).Error; err != nil {
+			return err
+		}
+		if err := r.data.DB(ctx).Where("id = ? ", id).Updates(&user).Error; err != nil {
+			return err
+		}
+		// Updates 不会写 false
+		if u.Private == nil {
+			return nil
+		}
+		if err := r.data.DB(ctx).Model(&User{}).Where("id = ?", id).Update("private", *u.Private).Error; err != nil {
+			return err
+		}
+		// 公开账号没有待批准的关注请求
+		if *u.Private {
+			return nil
+		}
+		return r.data.DB(ctx).Where("follow_id = ?", id).Delete(&FollowRequest{}).Error
+	})
+	if err != nil {
 		return err
 	}
-	// 更新所有文章的信息
-	r.data.db.Where("author_id = ? ", id).Updates(&Article{Email: u.Email, Username: u.Username, Image: u.Image})
+
+	// 清除缓存的用户信息
+	r.data.cache.del(ctx, profileCacheKey(old.Username), profileIdCacheKey(id))
 	return nil
 }
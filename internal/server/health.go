@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// ReadinessChecker reports whether the service can take traffic.
+type ReadinessChecker interface {
+	Ready(ctx context.Context) error
+}
+
+// registerHealth adds the liveness (/healthz) and readiness (/readyz) probes,
+// outside of the api middlewares.
+func registerHealth(srv *http.Server, rc ReadinessChecker) {
+	srv.HandleFunc("/healthz", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		w.Write([]byte("ok"))
+	})
+	srv.HandleFunc("/readyz", func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		if err := rc.Ready(r.Context()); err != nil {
+			stdhttp.Error(w, err.Error(), stdhttp.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	})
+}
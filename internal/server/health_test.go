@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+	"errors"
+	stdhttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+type readyFunc func(ctx context.Context) error
+
+func (f readyFunc) Ready(ctx context.Context) error { return f(ctx) }
+
+func TestHealth(t *testing.T) {
+	var down error
+	srv := http.NewServer()
+	registerHealth(srv, readyFunc(func(context.Context) error { return down }))
+	get := func(path string) int {
+		t.Helper()
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w.Code
+	}
+
+	for _, c := range []struct {
+		down            error
+		healthz, readyz int
+	}{
+		{nil, stdhttp.StatusOK, stdhttp.StatusOK},
+		{errors.New("database is not ready"), stdhttp.StatusOK, stdhttp.StatusServiceUnavailable},
+	} {
+		down = c.down
+		if got := get("/healthz"); got != c.healthz {
+			t.Errorf("down %v: /healthz = %d, want %d", c.down, got, c.healthz)
+		}
+		if got := get("/readyz"); got != c.readyz {
+			t.Errorf("down %v: /readyz = %d, want %d", c.down, got, c.readyz)
+		}
+	}
+}
@@ -22,13 +22,18 @@ import (
 func NewSkipRoutersMatcher() selector.MatchFunc {
 
 	skipRouters := map[string]struct{}{
-		"/user.v1.User/Authentication":     {},
-		"/user.v1.User/Registration":       {},
-		"/article.v1.Article/GetArticle":   {},
-		"/article.v1.Article/ListArticles": {},
-		"/article.v1.Article/GetComments":  {},
-		"/article.v1.Article/GetTags":      {},
-		"/profile.v1.Profile/GetProfile":   {},
+		"/user.v1.User/Authentication":       {},
+		"/user.v1.User/Registration":         {},
+		"/article.v1.Article/GetArticle":     {},
+		"/article.v1.Article/ListArticles":   {},
+		"/article.v1.Article/SearchArticles": {},
+		"/article.v1.Article/GetComments":    {},
+		"/article.v1.Article/GetTags":        {},
+		"/article.v1.Article/GetSeries":      {},
+		"/article.v1.Article/RenderMarkdown": {},
+		"/profile.v1.Profile/GetProfile":     {},
+		"/profile.v1.Profile/ListFollowers":  {},
+		"/profile.v1.Profile/ListFollowing":  {},
 	}
 
 	return func(ctx context.Context, operation string) bool {
@@ -46,7 +51,7 @@ func NewSkipRoutersMatcher() selector.MatchFunc {
 }
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, user *service.UserService, profile *service.ProfileService, article *service.ArticleService, jwtc *conf.JWT, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, user *service.UserService, profile *service.ProfileService, article *service.ArticleService, jwtc *conf.JWT, ready ReadinessChecker, logger log.Logger) *http.Server {
 	var (
 		opts = []http.ServerOption{
 			http.ErrorEncoder(errorEncoder),
@@ -78,6 +83,7 @@ func NewHTTPServer(c *conf.Server, user *service.UserService, profile *service.P
 	userServer.RegisterUserHTTPServer(srv, user)
 	profileService.RegisterProfileHTTPServer(srv, profile)
 	articleServer.RegisterArticleHTTPServer(srv, article)
+	registerHealth(srv, ready)
 
 	return srv
 }
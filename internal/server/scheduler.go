@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"realworld/internal/biz"
+	"realworld/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultSchedulerInterval = 30 * time.Second
+	defaultTrashRetention    = 30 * 24 * time.Hour
+)
+
+type job struct {
+	name string
+	run  func(ctx context.Context) error
+}
+
+// Scheduler runs the background jobs of the app every interval. It is a
+// kratos transport.Server, so the app starts and stops it with the others.
+type Scheduler struct {
+	interval time.Duration
+	jobs     []job
+	log      *log.Helper
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewScheduler new a scheduler.
+func NewScheduler(c *conf.Server, social *biz.SocialUsecase, logger log.Logger) *Scheduler {
+	s := &Scheduler{
+		interval: defaultSchedulerInterval,
+		log:      log.NewHelper(logger),
+		stop:     make(chan struct{}),
+	}
+	if c.Scheduler != nil && c.Scheduler.Interval.AsDuration() > 0 {
+		s.interval = c.Scheduler.Interval.AsDuration()
+	}
+	retention := defaultTrashRetention
+	if c.Scheduler != nil && c.Scheduler.TrashRetention.AsDuration() > 0 {
+		retention = c.Scheduler.TrashRetention.AsDuration()
+	}
+	s.jobs = []job{
+		{name: "publish scheduled articles", run: func(ctx context.Context) error {
+			published, err := social.PublishDue(ctx, time.Now())
+			for _, a := range published {
+				s.log.WithContext(ctx).Infof("published scheduled article %s", a.Slug)
+			}
+			return err
+		}},
+		{name: "purge trash", run: func(ctx context.Context) error {
+			articles, comments, err := social.PurgeTrash(ctx, time.Now().Add(-retention))
+			if articles > 0 || comments > 0 {
+				s.log.WithContext(ctx).Infof("purged %d articles and %d comments from the trash", articles, comments)
+			}
+			return err
+		}},
+		{name: "trim timelines", run: func(ctx context.Context) error {
+			n, err := social.TrimTimelines(ctx)
+			if n > 0 {
+				s.log.WithContext(ctx).Infof("trimmed %d articles from timelines", n)
+			}
+			return err
+		}},
+	}
+	return s
+}
+
+// Start runs the jobs until the app stops. A failing job is logged and tried
+// again at the next tick.
+func (s *Scheduler) Start(ctx context.Context) error {
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		for _, j := range s.jobs {
+			if err := j.run(ctx); err != nil {
+				s.log.WithContext(ctx).Warnf("job %s: %v", j.name, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+func (s *Scheduler) Stop(context.Context) error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return nil
+}
@@ -3,9 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
-	"realworld/pkg/middleware/auth"
-	"strconv"
+	"net/url"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/transport"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "realworld/api/article/v1"
@@ -22,8 +23,8 @@ func NewArticleService(uc *biz.SocialUsecase) *ArticleService {
 }
 
 func convertArticle(do *biz.Article) *pb.Articles {
-	return &pb.Articles{
-		Slug:           strconv.Itoa(int(do.ID)),
+	rv := &pb.Articles{
+		Slug:           do.Slug,
 		Title:          do.Title,
 		Description:    do.Description,
 		Body:           do.Body,
@@ -33,7 +34,43 @@ func convertArticle(do *biz.Article) *pb.Articles {
 		Favorited:      do.Favorited,
 		FavoritesCount: do.FavoritesCount,
 		Author:         convertProfile(do.Author),
+		Status:         string(do.Status),
+		PublishAt:      timestamp(do.PublishAt),
+		DeletedAt:      timestamp(do.DeletedAt),
 	}
+	rv.Series = convertArticleSeries(do.Series)
+	rv.Authors = append(rv.Authors, rv.Author)
+	for _, p := range do.CoAuthors {
+		rv.Authors = append(rv.Authors, convertProfile(p))
+	}
+	if r := do.Rendering; r != nil {
+		rv.BodyHtml, rv.Toc, rv.WordCount, rv.ReadingTime = r.HTML, convertTOC(r.TOC), r.WordCount, r.ReadingTime
+	}
+	return rv
+}
+
+func convertTOC(toc []biz.Heading) []*pb.Heading {
+	rv := make([]*pb.Heading, len(toc))
+	for i, h := range toc {
+		rv[i] = &pb.Heading{Level: int32(h.Level), Id: h.ID, Text: h.Text}
+	}
+	return rv
+}
+
+// timestamp is timestamppb.New that leaves zero times unset.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// asTime is the inverse of timestamp.
+func asTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
 }
 
 func convertComment(do *biz.Comment) *pb.Comment {
@@ -43,6 +80,7 @@ func convertComment(do *biz.Comment) *pb.Comment {
 		UpdatedAt: timestamppb.New(do.UpdatedAt),
 		Body:      do.Body,
 		Author:    convertProfile(do.Author),
+		DeletedAt: timestamp(do.DeletedAt),
 	}
 }
 
@@ -60,6 +98,12 @@ func (s *ArticleService) GetArticle(ctx context.Context, req *pb.GetArticleReque
 	if err != nil {
 		return nil, err
 	}
+	// 旧的 slug 也能访问, 告诉客户端现在的地址
+	if rv.Slug != req.Slug {
+		if tr, ok := transport.FromServerContext(ctx); ok {
+			tr.ReplyHeader().Set("Content-Location", "/api/articles/"+url.PathEscape(rv.Slug))
+		}
+	}
 	return &pb.SingleArticleReply{
 		Article: convertArticle(rv),
 	}, nil
@@ -71,6 +115,8 @@ func (s *ArticleService) CreateArticle(ctx context.Context, req *pb.CreateArticl
 		Description: req.Article.Description,
 		Body:        req.Article.Body,
 		TagList:     req.Article.TagList,
+		Status:      biz.ArticleStatus(req.Article.Status),
+		PublishAt:   asTime(req.Article.PublishAt),
 	})
 	if err != nil {
 		return nil, err
@@ -86,6 +132,8 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, req *pb.UpdateArticl
 		Description: req.Article.Description,
 		Body:        req.Article.Body,
 		TagList:     req.Article.TagList,
+		Status:      biz.ArticleStatus(req.Article.Status),
+		PublishAt:   asTime(req.Article.PublishAt),
 		Slug:        req.Slug,
 	})
 	if err != nil {
@@ -120,8 +168,8 @@ func (s *ArticleService) AddComment(ctx context.Context, req *pb.AddCommentReque
 	}, nil
 }
 
-func (s *ArticleService) GetComments(ctx context.Context, req *pb.AddCommentRequest) (reply *pb.MultipleCommentsReply, err error) {
-	rv, err := s.uc.ListComments(ctx, req.Slug)
+func (s *ArticleService) GetComments(ctx context.Context, req *pb.GetCommentsRequest) (reply *pb.MultipleCommentsReply, err error) {
+	rv, next, err := s.uc.ListComments(ctx, req.Slug, req.Cursor, req.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +177,7 @@ func (s *ArticleService) GetComments(ctx context.Context, req *pb.AddCommentRequ
 	for _, x := range rv {
 		comments = append(comments, convertComment(x))
 	}
-	return &pb.MultipleCommentsReply{Comments: comments}, nil
+	return &pb.MultipleCommentsReply{Comments: comments, NextCursor: next}, nil
 }
 
 func (s *ArticleService) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (reply *pb.SingleCommentReply, err error) {
@@ -141,14 +189,75 @@ func (s *ArticleService) DeleteComment(ctx context.Context, req *pb.DeleteCommen
 	}, err
 }
 
+func (s *ArticleService) ListMyDrafts(ctx context.Context, req *pb.ListMyDraftsRequest) (reply *pb.MultipleArticlesReply, err error) {
+	rv, count, next, err := s.uc.ListMyDrafts(ctx, biz.ArticleQuery{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	articles := make([]*pb.Articles, 0)
+	for _, x := range rv {
+		articles = append(articles, convertArticle(x))
+	}
+	return &pb.MultipleArticlesReply{Articles: articles, ArticlesCount: uint64(count), NextCursor: next}, nil
+}
+
+func (s *ArticleService) RenderMarkdown(ctx context.Context, req *pb.RenderMarkdownRequest) (reply *pb.RenderMarkdownReply, err error) {
+	r, err := s.uc.RenderPreview(ctx, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenderMarkdownReply{
+		BodyHtml:    r.HTML,
+		Toc:         convertTOC(r.TOC),
+		WordCount:   r.WordCount,
+		ReadingTime: r.ReadingTime,
+	}, nil
+}
+
+func (s *ArticleService) SearchArticles(ctx context.Context, req *pb.SearchArticlesRequest) (reply *pb.SearchArticlesReply, err error) {
+	allTags, err := biz.ParseTagMatch(req.TagMatch)
+	if err != nil {
+		return nil, err
+	}
+	rv, count, err := s.uc.SearchArticles(ctx, biz.SearchQuery{
+		ArticleQuery: biz.ArticleQuery{
+			Author:        req.Author,
+			Tags:          biz.CleanTags(append([]string{req.Tag}, req.Tags...)...),
+			AllTags:       allTags,
+			ExcludeTags:   biz.CleanTags(req.ExcludeTags...),
+			CreatedAfter:  asTime(req.CreatedAfter),
+			CreatedBefore: asTime(req.CreatedBefore),
+			FavoritedBy:   req.Favorited,
+			SeriesID:      uint(req.Series),
+			Limit:         req.Limit,
+			Offset:        req.Offset,
+		},
+		Text: req.Q,
+	})
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*pb.SearchResult, 0)
+	for _, x := range rv {
+		results = append(results, &pb.SearchResult{
+			Article:    convertArticle(x.Article),
+			Score:      x.Score,
+			Highlights: x.Highlights,
+		})
+	}
+	return &pb.SearchArticlesReply{Results: results, ResultsCount: uint64(count)}, nil
+}
+
 func (s *ArticleService) FeedArticles(ctx context.Context, req *pb.FeedArticlesRequest) (reply *pb.MultipleArticlesReply, err error) {
-	uid := auth.GetUserIdOrNotLogin(ctx)
-	rv, count, err := s.uc.ListArticles(ctx,
-		biz.ListOptions{},
-		biz.DbLimit(req.Limit),
-		biz.DbOffset(req.Offset),
-		biz.DbAuthorId(uid),
-	)
+	rv, count, next, err := s.uc.FeedArticles(ctx, biz.ArticleQuery{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+		Cursor: req.Cursor,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -156,21 +265,34 @@ func (s *ArticleService) FeedArticles(ctx context.Context, req *pb.FeedArticlesR
 	for _, x := range rv {
 		articles = append(articles, convertArticle(x))
 	}
-	return &pb.MultipleArticlesReply{Articles: articles, ArticlesCount: uint64(count)}, nil
+	return &pb.MultipleArticlesReply{Articles: articles, ArticlesCount: uint64(count), NextCursor: next}, nil
 }
 
 func (s *ArticleService) ListArticles(ctx context.Context, req *pb.ListArticlesRequest) (reply *pb.MultipleArticlesReply, err error) {
 	fmt.Println("ListArticles", req)
-	los := biz.ListOptions{
-		Favorited: req.Favorited,
-		Tag:       req.Tag,
+	sort, asc, err := biz.ParseArticleSort(req.Sort, req.Order)
+	if err != nil {
+		return nil, err
 	}
-	rv, count, err := s.uc.ListArticles(ctx,
-		los,
-		biz.DbLimit(req.Limit),
-		biz.DbOffset(req.Offset),
-		biz.DbAuthor(req.Author),
-	)
+	allTags, err := biz.ParseTagMatch(req.TagMatch)
+	if err != nil {
+		return nil, err
+	}
+	rv, count, next, err := s.uc.ListArticles(ctx, biz.ArticleQuery{
+		Author:        req.Author,
+		Tags:          biz.CleanTags(append([]string{req.Tag}, req.Tags...)...),
+		AllTags:       allTags,
+		ExcludeTags:   biz.CleanTags(req.ExcludeTags...),
+		CreatedAfter:  asTime(req.CreatedAfter),
+		CreatedBefore: asTime(req.CreatedBefore),
+		FavoritedBy:   req.Favorited,
+		SeriesID:      uint(req.Series),
+		Limit:         req.Limit,
+		Offset:        req.Offset,
+		Sort:          sort,
+		Ascending:     asc,
+		Cursor:        req.Cursor,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -178,7 +300,7 @@ func (s *ArticleService) ListArticles(ctx context.Context, req *pb.ListArticlesR
 	for _, x := range rv {
 		articles = append(articles, convertArticle(x))
 	}
-	return &pb.MultipleArticlesReply{Articles: articles, ArticlesCount: uint64(count)}, nil
+	return &pb.MultipleArticlesReply{Articles: articles, ArticlesCount: uint64(count), NextCursor: next}, nil
 }
 
 func (s *ArticleService) GetTags(ctx context.Context, req *pb.GetTagsRequest) (reply *pb.TagListReply, err error) {
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "realworld/api/article/v1"
+	"realworld/internal/biz"
+)
+
+func convertInvitation(do *biz.CoAuthor) *pb.Invitation {
+	return &pb.Invitation{
+		Article:   convertArticle(do.Article),
+		User:      convertProfile(do.User),
+		InvitedBy: convertProfile(do.Inviter),
+		CreatedAt: timestamppb.New(do.CreatedAt),
+	}
+}
+
+func (s *ArticleService) InviteCoAuthor(ctx context.Context, req *pb.InviteCoAuthorRequest) (reply *pb.SingleInvitationReply, err error) {
+	rv, err := s.uc.InviteCoAuthor(ctx, req.Slug, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleInvitationReply{Invitation: convertInvitation(rv)}, nil
+}
+
+func (s *ArticleService) AcceptCoAuthor(ctx context.Context, req *pb.AcceptCoAuthorRequest) (reply *pb.SingleArticleReply, err error) {
+	rv, err := s.uc.AcceptCoAuthor(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleArticleReply{Article: convertArticle(rv)}, nil
+}
+
+func (s *ArticleService) RemoveCoAuthor(ctx context.Context, req *pb.RemoveCoAuthorRequest) (reply *pb.SingleArticleReply, err error) {
+	if err := s.uc.RemoveCoAuthor(ctx, req.Slug, req.Username); err != nil {
+		return nil, err
+	}
+	return &pb.SingleArticleReply{Article: &pb.Articles{Slug: req.Slug}}, nil
+}
+
+func (s *ArticleService) ListInvitations(ctx context.Context, req *pb.ListInvitationsRequest) (reply *pb.MultipleInvitationsReply, err error) {
+	rv, err := s.uc.ListInvitations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invitations := make([]*pb.Invitation, 0, len(rv))
+	for _, x := range rv {
+		invitations = append(invitations, convertInvitation(x))
+	}
+	return &pb.MultipleInvitationsReply{Invitations: invitations, InvitationsCount: uint64(len(invitations))}, nil
+}
@@ -17,20 +17,29 @@ func NewProfileService(uc *biz.ProfileUsecase) *ProfileService {
 	return &ProfileService{uc: uc}
 }
 
+func convertProfileReply(p *biz.Profile) *pb.ProfileReply_Profile {
+	return &pb.ProfileReply_Profile{
+		Username:       p.Username,
+		Bio:            p.Bio,
+		Image:          p.Image,
+		Following:      p.Following,
+		FollowersCount: p.FollowersCount,
+		FollowingCount: p.FollowingCount,
+		ArticlesCount:  p.ArticlesCount,
+		Blocking:       p.Blocking,
+		Muting:         p.Muting,
+		Private:        p.Private,
+		Requested:      p.Requested,
+	}
+}
+
 func (s *ProfileService) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.ProfileReply, error) {
 	userId := auth.GetUserIdOrNotLogin(ctx)
 	reply, err := s.uc.GetProfile(ctx, userId, req.GetUsername())
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ProfileReply{
-		Profile: &pb.ProfileReply_Profile{
-			Username:  reply.Username,
-			Bio:       reply.Bio,
-			Image:     reply.Image,
-			Following: reply.Following,
-		},
-	}, nil
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
 }
 func (s *ProfileService) FollowUser(ctx context.Context, req *pb.FollowUserRequest) (*pb.ProfileReply, error) {
 	cu := auth.FromContext(ctx)
@@ -38,12 +47,7 @@ func (s *ProfileService) FollowUser(ctx context.Context, req *pb.FollowUserReque
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ProfileReply{Profile: &pb.ProfileReply_Profile{
-		Username:  reply.Username,
-		Bio:       reply.Bio,
-		Image:     reply.Image,
-		Following: true,
-	}}, nil
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
 }
 func (s *ProfileService) UnfollowUser(ctx context.Context, req *pb.UnfollowUserRequest) (*pb.ProfileReply, error) {
 	cu := auth.FromContext(ctx)
@@ -51,10 +55,85 @@ func (s *ProfileService) UnfollowUser(ctx context.Context, req *pb.UnfollowUserR
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ProfileReply{Profile: &pb.ProfileReply_Profile{
-		Username:  reply.Username,
-		Bio:       reply.Bio,
-		Image:     reply.Image,
-		Following: false,
-	}}, nil
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
+}
+
+func (s *ProfileService) ListFollowers(ctx context.Context, req *pb.ListFollowsRequest) (*pb.ProfilesReply, error) {
+	rv, count, err := s.uc.ListFollowers(ctx, auth.GetUserIdOrNotLogin(ctx), req.Username, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfiles(rv, count), nil
+}
+
+func (s *ProfileService) ListFollowing(ctx context.Context, req *pb.ListFollowsRequest) (*pb.ProfilesReply, error) {
+	rv, count, err := s.uc.ListFollowing(ctx, auth.GetUserIdOrNotLogin(ctx), req.Username, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfiles(rv, count), nil
+}
+
+func (s *ProfileService) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.ProfileReply, error) {
+	reply, err := s.uc.BlockUser(ctx, auth.FromContext(ctx).UserID, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
+}
+
+func (s *ProfileService) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.ProfileReply, error) {
+	reply, err := s.uc.UnblockUser(ctx, auth.FromContext(ctx).UserID, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
+}
+
+func (s *ProfileService) MuteUser(ctx context.Context, req *pb.MuteUserRequest) (*pb.ProfileReply, error) {
+	reply, err := s.uc.MuteUser(ctx, auth.FromContext(ctx).UserID, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
+}
+
+func (s *ProfileService) UnmuteUser(ctx context.Context, req *pb.UnmuteUserRequest) (*pb.ProfileReply, error) {
+	reply, err := s.uc.UnmuteUser(ctx, auth.FromContext(ctx).UserID, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
+}
+
+func (s *ProfileService) ListFollowRequests(ctx context.Context, req *pb.ListFollowRequestsRequest) (*pb.ProfilesReply, error) {
+	rv, count, err := s.uc.ListFollowRequests(ctx, auth.FromContext(ctx).UserID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	return convertProfiles(rv, count), nil
+}
+
+func (s *ProfileService) ApproveFollowRequest(ctx context.Context, req *pb.ApproveFollowRequestRequest) (*pb.ProfileReply, error) {
+	reply, err := s.uc.ApproveFollowRequest(ctx, auth.FromContext(ctx).UserID, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
+}
+
+func (s *ProfileService) DenyFollowRequest(ctx context.Context, req *pb.DenyFollowRequestRequest) (*pb.ProfileReply, error) {
+	reply, err := s.uc.DenyFollowRequest(ctx, auth.FromContext(ctx).UserID, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProfileReply{Profile: convertProfileReply(reply)}, nil
+}
+
+func convertProfiles(ps []*biz.Profile, count int64) *pb.ProfilesReply {
+	profiles := make([]*pb.ProfileReply_Profile, 0)
+	for _, p := range ps {
+		profiles = append(profiles, convertProfileReply(p))
+	}
+	return &pb.ProfilesReply{Profiles: profiles, ProfilesCount: uint64(count)}
 }
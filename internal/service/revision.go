@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "realworld/api/article/v1"
+	"realworld/internal/biz"
+)
+
+var diffOps = map[biz.DiffOp]string{
+	biz.DiffEqual:  "equal",
+	biz.DiffInsert: "insert",
+	biz.DiffDelete: "delete",
+}
+
+func convertRevision(do *biz.Revision) *pb.Revision {
+	return &pb.Revision{
+		Number:       do.Number,
+		Title:        do.Title,
+		Description:  do.Description,
+		Body:         do.Body,
+		TagList:      do.TagList,
+		CreatedAt:    timestamppb.New(do.CreatedAt),
+		Author:       convertProfile(do.Author),
+		RestoredFrom: do.RestoredFrom,
+	}
+}
+
+func (s *ArticleService) ListRevisions(ctx context.Context, req *pb.ListRevisionsRequest) (reply *pb.MultipleRevisionsReply, err error) {
+	rv, err := s.uc.ListRevisions(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*pb.Revision, 0, len(rv))
+	for _, x := range rv {
+		revisions = append(revisions, convertRevision(x))
+	}
+	return &pb.MultipleRevisionsReply{Revisions: revisions, RevisionsCount: uint64(len(rv))}, nil
+}
+
+func (s *ArticleService) GetRevision(ctx context.Context, req *pb.GetRevisionRequest) (reply *pb.SingleRevisionReply, err error) {
+	rv, err := s.uc.GetRevision(ctx, req.Slug, req.Number)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleRevisionReply{Revision: convertRevision(rv)}, nil
+}
+
+func (s *ArticleService) DiffRevisions(ctx context.Context, req *pb.DiffRevisionsRequest) (reply *pb.RevisionDiffReply, err error) {
+	rv, err := s.uc.DiffRevisions(ctx, req.Slug, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	body := make([]*pb.DiffLine, len(rv.Body))
+	for i, x := range rv.Body {
+		body[i] = &pb.DiffLine{Op: diffOps[x.Op], Text: x.Text}
+	}
+	return &pb.RevisionDiffReply{
+		From:        convertRevision(rv.From),
+		To:          convertRevision(rv.To),
+		Body:        body,
+		TagsAdded:   rv.TagsAdded,
+		TagsRemoved: rv.TagsRemoved,
+	}, nil
+}
+
+func (s *ArticleService) RestoreRevision(ctx context.Context, req *pb.RestoreRevisionRequest) (reply *pb.SingleArticleReply, err error) {
+	rv, err := s.uc.RestoreRevision(ctx, req.Slug, req.Number)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleArticleReply{
+		Article: convertArticle(rv),
+	}, nil
+}
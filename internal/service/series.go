@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "realworld/api/article/v1"
+	"realworld/internal/biz"
+)
+
+func convertSeries(do *biz.Series) *pb.Series {
+	articles := make([]*pb.Articles, 0, len(do.Articles))
+	for _, x := range do.Articles {
+		articles = append(articles, convertArticle(x))
+	}
+	return &pb.Series{
+		Id:        uint64(do.ID),
+		Title:     do.Title,
+		Author:    convertProfile(do.Author),
+		Articles:  articles,
+		CreatedAt: timestamppb.New(do.CreatedAt),
+		UpdatedAt: timestamppb.New(do.UpdatedAt),
+	}
+}
+
+func convertArticleSeries(do *biz.ArticleSeries) *pb.ArticleSeries {
+	if do == nil {
+		return nil
+	}
+	return &pb.ArticleSeries{
+		Id:       uint64(do.ID),
+		Title:    do.Title,
+		Position: uint32(do.Position),
+		Count:    uint32(do.Count),
+		Prev:     do.Prev,
+		Next:     do.Next,
+	}
+}
+
+func (s *ArticleService) CreateSeries(ctx context.Context, req *pb.CreateSeriesRequest) (reply *pb.SingleSeriesReply, err error) {
+	rv, err := s.uc.CreateSeries(ctx, req.Series.GetTitle(), req.Series.GetArticles())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleSeriesReply{Series: convertSeries(rv)}, nil
+}
+
+func (s *ArticleService) GetSeries(ctx context.Context, req *pb.GetSeriesRequest) (reply *pb.SingleSeriesReply, err error) {
+	rv, err := s.uc.GetSeries(ctx, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleSeriesReply{Series: convertSeries(rv)}, nil
+}
+
+func (s *ArticleService) UpdateSeries(ctx context.Context, req *pb.UpdateSeriesRequest) (reply *pb.SingleSeriesReply, err error) {
+	rv, err := s.uc.RenameSeries(ctx, uint(req.Id), req.Series.GetTitle())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleSeriesReply{Series: convertSeries(rv)}, nil
+}
+
+func (s *ArticleService) SetSeriesArticles(ctx context.Context, req *pb.SetSeriesArticlesRequest) (reply *pb.SingleSeriesReply, err error) {
+	rv, err := s.uc.SetSeriesArticles(ctx, uint(req.Id), req.Articles)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleSeriesReply{Series: convertSeries(rv)}, nil
+}
+
+func (s *ArticleService) DeleteSeries(ctx context.Context, req *pb.DeleteSeriesRequest) (reply *pb.SingleSeriesReply, err error) {
+	if err := s.uc.DeleteSeries(ctx, uint(req.Id)); err != nil {
+		return nil, err
+	}
+	return &pb.SingleSeriesReply{Series: &pb.Series{Id: req.Id}}, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	pb "realworld/api/article/v1"
+)
+
+func (s *ArticleService) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (reply *pb.TrashReply, err error) {
+	rv, err := s.uc.ListTrash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply = &pb.TrashReply{
+		Articles: make([]*pb.Articles, 0, len(rv.Articles)),
+		Comments: make([]*pb.TrashedComment, 0, len(rv.Comments)),
+	}
+	for _, x := range rv.Articles {
+		reply.Articles = append(reply.Articles, convertArticle(x))
+	}
+	for _, x := range rv.Comments {
+		reply.Comments = append(reply.Comments, &pb.TrashedComment{
+			Comment: convertComment(x),
+			Slug:    x.Article.Slug,
+			Title:   x.Article.Title,
+		})
+	}
+	return reply, nil
+}
+
+func (s *ArticleService) RestoreArticle(ctx context.Context, req *pb.RestoreArticleRequest) (reply *pb.SingleArticleReply, err error) {
+	rv, err := s.uc.RestoreArticle(ctx, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleArticleReply{Article: convertArticle(rv)}, nil
+}
+
+func (s *ArticleService) RestoreComment(ctx context.Context, req *pb.RestoreCommentRequest) (reply *pb.SingleCommentReply, err error) {
+	rv, err := s.uc.RestoreComment(ctx, req.Slug, uint(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SingleCommentReply{Comment: convertComment(rv)}, nil
+}
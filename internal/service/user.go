@@ -71,6 +71,7 @@ func (s *UserService) GetCurrentUser(ctx context.Context, in *v1.GetCurrentUserR
 			Username: rv.Username,
 			Bio:      rv.Bio,
 			Image:    rv.Image,
+			Private:  rv.Private,
 		},
 	}, nil
 
@@ -83,6 +84,7 @@ func (s *UserService) UpdateUser(ctx context.Context, in *v1.UpdateUserRequest)
 		Bio:      in.User.GetBio(),
 		Image:    in.User.GetImage(),
 		Username: in.User.GetUsername(),
+		Private:  in.User.Private,
 	}
 
 	if len(in.User.GetPassword()) > 0 {
@@ -100,5 +102,6 @@ func (s *UserService) UpdateUser(ctx context.Context, in *v1.UpdateUserRequest)
 			Token:    rv.Token,
 			Bio:      rv.Bio,
 			Username: rv.Username,
+			Private:  rv.Private,
 		}}, nil
 }